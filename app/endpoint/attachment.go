@@ -0,0 +1,74 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// AttachmentServiceEndpoints is a set of domain service.AttachmentService's
+// endpoints.
+type AttachmentServiceEndpoints struct {
+	ListAttachmentsEndpoint    endpoint.Endpoint
+	UploadAttachmentEndpoint   endpoint.Endpoint
+	DownloadAttachmentEndpoint endpoint.Endpoint
+	DeleteAttachmentEndpoint   endpoint.Endpoint
+}
+
+// NewAttachmentServiceEndpoints creates and returns a new instance of
+// AttachmentServiceEndpoints.
+func NewAttachmentServiceEndpoints(
+	svc service.AttachmentService,
+	authClient golangkitauth.AuthenticateClient,
+) *AttachmentServiceEndpoints {
+	epts := &AttachmentServiceEndpoints{}
+
+	epts.ListAttachmentsEndpoint = newListAttachmentsEndpoint(svc)
+	epts.ListAttachmentsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListAttachmentsEndpoint)
+
+	epts.UploadAttachmentEndpoint = newUploadAttachmentEndpoint(svc)
+	epts.UploadAttachmentEndpoint = golangkitauth.Authenticate(authClient)(epts.UploadAttachmentEndpoint)
+
+	epts.DownloadAttachmentEndpoint = newDownloadAttachmentEndpoint(svc)
+	epts.DownloadAttachmentEndpoint = golangkitauth.Authenticate(authClient)(epts.DownloadAttachmentEndpoint)
+
+	epts.DeleteAttachmentEndpoint = newDeleteAttachmentEndpoint(svc)
+	epts.DeleteAttachmentEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteAttachmentEndpoint)
+
+	return epts
+}
+
+// newListAttachmentsEndpoint creates and returns a new endpoint for
+// ListAttachments use case.
+func newListAttachmentsEndpoint(svc service.AttachmentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListAttachments(ctx, request.(*service.ListAttachmentsRequest))
+	}
+}
+
+// newUploadAttachmentEndpoint creates and returns a new endpoint for
+// UploadAttachment use case.
+func newUploadAttachmentEndpoint(svc service.AttachmentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UploadAttachment(ctx, request.(*service.UploadAttachmentRequest))
+	}
+}
+
+// newDownloadAttachmentEndpoint creates and returns a new endpoint for
+// DownloadAttachment use case.
+func newDownloadAttachmentEndpoint(svc service.AttachmentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DownloadAttachment(ctx, request.(*service.DownloadAttachmentRequest))
+	}
+}
+
+// newDeleteAttachmentEndpoint creates and returns a new endpoint for
+// DeleteAttachment use case.
+func newDeleteAttachmentEndpoint(svc service.AttachmentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteAttachment(ctx, request.(*service.DeleteAttachmentRequest))
+	}
+}
@@ -0,0 +1,86 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// ChecklistServiceEndpoints is a set of domain service.ChecklistService's
+// endpoints.
+type ChecklistServiceEndpoints struct {
+	ListChecklistItemsEndpoint    endpoint.Endpoint
+	AddChecklistItemEndpoint      endpoint.Endpoint
+	ReorderChecklistItemsEndpoint endpoint.Endpoint
+	UpdateChecklistItemEndpoint   endpoint.Endpoint
+	DeleteChecklistItemEndpoint   endpoint.Endpoint
+}
+
+// NewChecklistServiceEndpoints creates and returns a new instance of
+// ChecklistServiceEndpoints.
+func NewChecklistServiceEndpoints(
+	svc service.ChecklistService,
+	authClient golangkitauth.AuthenticateClient,
+) *ChecklistServiceEndpoints {
+	epts := &ChecklistServiceEndpoints{}
+
+	epts.ListChecklistItemsEndpoint = newListChecklistItemsEndpoint(svc)
+	epts.ListChecklistItemsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListChecklistItemsEndpoint)
+
+	epts.AddChecklistItemEndpoint = newAddChecklistItemEndpoint(svc)
+	epts.AddChecklistItemEndpoint = golangkitauth.Authenticate(authClient)(epts.AddChecklistItemEndpoint)
+
+	epts.ReorderChecklistItemsEndpoint = newReorderChecklistItemsEndpoint(svc)
+	epts.ReorderChecklistItemsEndpoint = golangkitauth.Authenticate(authClient)(epts.ReorderChecklistItemsEndpoint)
+
+	epts.UpdateChecklistItemEndpoint = newUpdateChecklistItemEndpoint(svc)
+	epts.UpdateChecklistItemEndpoint = golangkitauth.Authenticate(authClient)(epts.UpdateChecklistItemEndpoint)
+
+	epts.DeleteChecklistItemEndpoint = newDeleteChecklistItemEndpoint(svc)
+	epts.DeleteChecklistItemEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteChecklistItemEndpoint)
+
+	return epts
+}
+
+// newListChecklistItemsEndpoint creates and returns a new endpoint for
+// ListChecklistItems use case.
+func newListChecklistItemsEndpoint(svc service.ChecklistService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListChecklistItems(ctx, request.(*service.ListChecklistItemsRequest))
+	}
+}
+
+// newAddChecklistItemEndpoint creates and returns a new endpoint for
+// AddChecklistItem use case.
+func newAddChecklistItemEndpoint(svc service.ChecklistService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AddChecklistItem(ctx, request.(*service.AddChecklistItemRequest))
+	}
+}
+
+// newReorderChecklistItemsEndpoint creates and returns a new endpoint for
+// ReorderChecklistItems use case.
+func newReorderChecklistItemsEndpoint(svc service.ChecklistService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ReorderChecklistItems(ctx, request.(*service.ReorderChecklistItemsRequest))
+	}
+}
+
+// newUpdateChecklistItemEndpoint creates and returns a new endpoint for
+// UpdateChecklistItem use case.
+func newUpdateChecklistItemEndpoint(svc service.ChecklistService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UpdateChecklistItem(ctx, request.(*service.UpdateChecklistItemRequest))
+	}
+}
+
+// newDeleteChecklistItemEndpoint creates and returns a new endpoint for
+// DeleteChecklistItem use case.
+func newDeleteChecklistItemEndpoint(svc service.ChecklistService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteChecklistItem(ctx, request.(*service.DeleteChecklistItemRequest))
+	}
+}
@@ -0,0 +1,74 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// CommentServiceEndpoints is a set of domain service.CommentService's
+// endpoints.
+type CommentServiceEndpoints struct {
+	ListCommentsEndpoint  endpoint.Endpoint
+	CreateCommentEndpoint endpoint.Endpoint
+	UpdateCommentEndpoint endpoint.Endpoint
+	DeleteCommentEndpoint endpoint.Endpoint
+}
+
+// NewCommentServiceEndpoints creates and returns a new instance of
+// CommentServiceEndpoints.
+func NewCommentServiceEndpoints(
+	svc service.CommentService,
+	authClient golangkitauth.AuthenticateClient,
+) *CommentServiceEndpoints {
+	epts := &CommentServiceEndpoints{}
+
+	epts.ListCommentsEndpoint = newListCommentsEndpoint(svc)
+	epts.ListCommentsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListCommentsEndpoint)
+
+	epts.CreateCommentEndpoint = newCreateCommentEndpoint(svc)
+	epts.CreateCommentEndpoint = golangkitauth.Authenticate(authClient)(epts.CreateCommentEndpoint)
+
+	epts.UpdateCommentEndpoint = newUpdateCommentEndpoint(svc)
+	epts.UpdateCommentEndpoint = golangkitauth.Authenticate(authClient)(epts.UpdateCommentEndpoint)
+
+	epts.DeleteCommentEndpoint = newDeleteCommentEndpoint(svc)
+	epts.DeleteCommentEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteCommentEndpoint)
+
+	return epts
+}
+
+// newListCommentsEndpoint creates and returns a new endpoint for
+// ListComments use case.
+func newListCommentsEndpoint(svc service.CommentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListComments(ctx, request.(*service.ListCommentsRequest))
+	}
+}
+
+// newCreateCommentEndpoint creates and returns a new endpoint for
+// CreateComment use case.
+func newCreateCommentEndpoint(svc service.CommentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CreateComment(ctx, request.(*service.CreateCommentRequest))
+	}
+}
+
+// newUpdateCommentEndpoint creates and returns a new endpoint for
+// UpdateComment use case.
+func newUpdateCommentEndpoint(svc service.CommentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UpdateComment(ctx, request.(*service.UpdateCommentRequest))
+	}
+}
+
+// newDeleteCommentEndpoint creates and returns a new endpoint for
+// DeleteComment use case.
+func newDeleteCommentEndpoint(svc service.CommentService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteComment(ctx, request.(*service.DeleteCommentRequest))
+	}
+}
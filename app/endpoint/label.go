@@ -0,0 +1,85 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// LabelServiceEndpoints is a set of domain service.LabelService's endpoints.
+type LabelServiceEndpoints struct {
+	ListLabelsEndpoint  endpoint.Endpoint
+	GetLabelEndpoint    endpoint.Endpoint
+	CreateLabelEndpoint endpoint.Endpoint
+	UpdateLabelEndpoint endpoint.Endpoint
+	DeleteLabelEndpoint endpoint.Endpoint
+}
+
+// NewLabelServiceEndpoints creates and returns a new instance of
+// LabelServiceEndpoints.
+func NewLabelServiceEndpoints(
+	svc service.LabelService,
+	authClient golangkitauth.AuthenticateClient,
+) *LabelServiceEndpoints {
+	epts := &LabelServiceEndpoints{}
+
+	epts.ListLabelsEndpoint = newListLabelsEndpoint(svc)
+	epts.ListLabelsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListLabelsEndpoint)
+
+	epts.GetLabelEndpoint = newGetLabelEndpoint(svc)
+	epts.GetLabelEndpoint = golangkitauth.Authenticate(authClient)(epts.GetLabelEndpoint)
+
+	epts.CreateLabelEndpoint = newCreateLabelEndpoint(svc)
+	epts.CreateLabelEndpoint = golangkitauth.Authenticate(authClient)(epts.CreateLabelEndpoint)
+
+	epts.UpdateLabelEndpoint = newUpdateLabelEndpoint(svc)
+	epts.UpdateLabelEndpoint = golangkitauth.Authenticate(authClient)(epts.UpdateLabelEndpoint)
+
+	epts.DeleteLabelEndpoint = newDeleteLabelEndpoint(svc)
+	epts.DeleteLabelEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteLabelEndpoint)
+
+	return epts
+}
+
+// newListLabelsEndpoint creates and returns a new endpoint for
+// ListLabels use case.
+func newListLabelsEndpoint(svc service.LabelService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListLabels(ctx, request.(*service.ListLabelsRequest))
+	}
+}
+
+// newGetLabelEndpoint creates and returns a new endpoint for
+// GetLabel use case.
+func newGetLabelEndpoint(svc service.LabelService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.GetLabel(ctx, request.(*service.GetLabelRequest))
+	}
+}
+
+// newCreateLabelEndpoint creates and returns a new endpoint for
+// CreateLabel use case.
+func newCreateLabelEndpoint(svc service.LabelService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CreateLabel(ctx, request.(*service.CreateLabelRequest))
+	}
+}
+
+// newUpdateLabelEndpoint creates and returns a new endpoint for
+// UpdateLabel use case.
+func newUpdateLabelEndpoint(svc service.LabelService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UpdateLabel(ctx, request.(*service.UpdateLabelRequest))
+	}
+}
+
+// newDeleteLabelEndpoint creates and returns a new endpoint for
+// DeleteLabel use case.
+func newDeleteLabelEndpoint(svc service.LabelService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteLabel(ctx, request.(*service.DeleteLabelRequest))
+	}
+}
@@ -0,0 +1,74 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// NotificationServiceEndpoints is a set of domain service.NotificationService's
+// endpoints.
+type NotificationServiceEndpoints struct {
+	ListNotificationsEndpoint        endpoint.Endpoint
+	CountUnreadNotificationsEndpoint endpoint.Endpoint
+	MarkAllNotificationsReadEndpoint endpoint.Endpoint
+	MarkNotificationReadEndpoint     endpoint.Endpoint
+}
+
+// NewNotificationServiceEndpoints creates and returns a new instance of
+// NotificationServiceEndpoints.
+func NewNotificationServiceEndpoints(
+	svc service.NotificationService,
+	authClient golangkitauth.AuthenticateClient,
+) *NotificationServiceEndpoints {
+	epts := &NotificationServiceEndpoints{}
+
+	epts.ListNotificationsEndpoint = newListNotificationsEndpoint(svc)
+	epts.ListNotificationsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListNotificationsEndpoint)
+
+	epts.CountUnreadNotificationsEndpoint = newCountUnreadNotificationsEndpoint(svc)
+	epts.CountUnreadNotificationsEndpoint = golangkitauth.Authenticate(authClient)(epts.CountUnreadNotificationsEndpoint)
+
+	epts.MarkAllNotificationsReadEndpoint = newMarkAllNotificationsReadEndpoint(svc)
+	epts.MarkAllNotificationsReadEndpoint = golangkitauth.Authenticate(authClient)(epts.MarkAllNotificationsReadEndpoint)
+
+	epts.MarkNotificationReadEndpoint = newMarkNotificationReadEndpoint(svc)
+	epts.MarkNotificationReadEndpoint = golangkitauth.Authenticate(authClient)(epts.MarkNotificationReadEndpoint)
+
+	return epts
+}
+
+// newListNotificationsEndpoint creates and returns a new endpoint for
+// ListNotifications use case.
+func newListNotificationsEndpoint(svc service.NotificationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListNotifications(ctx, request.(*service.ListNotificationsRequest))
+	}
+}
+
+// newCountUnreadNotificationsEndpoint creates and returns a new endpoint for
+// CountUnreadNotifications use case.
+func newCountUnreadNotificationsEndpoint(svc service.NotificationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CountUnreadNotifications(ctx, request.(*service.CountUnreadNotificationsRequest))
+	}
+}
+
+// newMarkAllNotificationsReadEndpoint creates and returns a new endpoint for
+// MarkAllNotificationsRead use case.
+func newMarkAllNotificationsReadEndpoint(svc service.NotificationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.MarkAllNotificationsRead(ctx, request.(*service.MarkAllNotificationsReadRequest))
+	}
+}
+
+// newMarkNotificationReadEndpoint creates and returns a new endpoint for
+// MarkNotificationRead use case.
+func newMarkNotificationReadEndpoint(svc service.NotificationService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.MarkNotificationRead(ctx, request.(*service.MarkNotificationReadRequest))
+	}
+}
@@ -0,0 +1,135 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// ProjectServiceEndpoints is a set of domain service.ProjectService's endpoints.
+type ProjectServiceEndpoints struct {
+	ListProjectsEndpoint  endpoint.Endpoint
+	GetProjectEndpoint    endpoint.Endpoint
+	CreateProjectEndpoint endpoint.Endpoint
+	UpdateProjectEndpoint endpoint.Endpoint
+	DeleteProjectEndpoint endpoint.Endpoint
+
+	ArchiveProjectEndpoint endpoint.Endpoint
+
+	AddProjectMemberEndpoint    endpoint.Endpoint
+	RemoveProjectMemberEndpoint endpoint.Endpoint
+	ListProjectMembersEndpoint  endpoint.Endpoint
+}
+
+// NewProjectServiceEndpoints creates and returns a new instance of
+// ProjectServiceEndpoints.
+func NewProjectServiceEndpoints(
+	svc service.ProjectService,
+	authClient golangkitauth.AuthenticateClient,
+) *ProjectServiceEndpoints {
+	epts := &ProjectServiceEndpoints{}
+
+	epts.ListProjectsEndpoint = newListProjectsEndpoint(svc)
+	epts.ListProjectsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListProjectsEndpoint)
+
+	epts.GetProjectEndpoint = newGetProjectEndpoint(svc)
+	epts.GetProjectEndpoint = golangkitauth.Authenticate(authClient)(epts.GetProjectEndpoint)
+
+	epts.CreateProjectEndpoint = newCreateProjectEndpoint(svc)
+	epts.CreateProjectEndpoint = golangkitauth.Authenticate(authClient)(epts.CreateProjectEndpoint)
+
+	epts.UpdateProjectEndpoint = newUpdateProjectEndpoint(svc)
+	epts.UpdateProjectEndpoint = golangkitauth.Authenticate(authClient)(epts.UpdateProjectEndpoint)
+
+	epts.DeleteProjectEndpoint = newDeleteProjectEndpoint(svc)
+	epts.DeleteProjectEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteProjectEndpoint)
+
+	epts.ArchiveProjectEndpoint = newArchiveProjectEndpoint(svc)
+	epts.ArchiveProjectEndpoint = golangkitauth.Authenticate(authClient)(epts.ArchiveProjectEndpoint)
+
+	epts.AddProjectMemberEndpoint = newAddProjectMemberEndpoint(svc)
+	epts.AddProjectMemberEndpoint = golangkitauth.Authenticate(authClient)(epts.AddProjectMemberEndpoint)
+
+	epts.RemoveProjectMemberEndpoint = newRemoveProjectMemberEndpoint(svc)
+	epts.RemoveProjectMemberEndpoint = golangkitauth.Authenticate(authClient)(epts.RemoveProjectMemberEndpoint)
+
+	epts.ListProjectMembersEndpoint = newListProjectMembersEndpoint(svc)
+	epts.ListProjectMembersEndpoint = golangkitauth.Authenticate(authClient)(epts.ListProjectMembersEndpoint)
+
+	return epts
+}
+
+// newListProjectsEndpoint creates and returns a new endpoint for
+// ListProjects use case.
+func newListProjectsEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListProjects(ctx, request.(*service.ListProjectsRequest))
+	}
+}
+
+// newGetProjectEndpoint creates and returns a new endpoint for
+// GetProject use case.
+func newGetProjectEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.GetProject(ctx, request.(*service.GetProjectRequest))
+	}
+}
+
+// newCreateProjectEndpoint creates and returns a new endpoint for
+// CreateProject use case.
+func newCreateProjectEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CreateProject(ctx, request.(*service.CreateProjectRequest))
+	}
+}
+
+// newUpdateProjectEndpoint creates and returns a new endpoint for
+// UpdateProject use case.
+func newUpdateProjectEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UpdateProject(ctx, request.(*service.UpdateProjectRequest))
+	}
+}
+
+// newDeleteProjectEndpoint creates and returns a new endpoint for
+// DeleteProject use case.
+func newDeleteProjectEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteProject(ctx, request.(*service.DeleteProjectRequest))
+	}
+}
+
+// newArchiveProjectEndpoint creates and returns a new endpoint for
+// ArchiveProject use case.
+func newArchiveProjectEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ArchiveProject(ctx, request.(*service.ArchiveProjectRequest))
+	}
+}
+
+// newAddProjectMemberEndpoint creates and returns a new endpoint for
+// AddProjectMember use case.
+func newAddProjectMemberEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AddProjectMember(ctx, request.(*service.AddProjectMemberRequest))
+	}
+}
+
+// newRemoveProjectMemberEndpoint creates and returns a new endpoint for
+// RemoveProjectMember use case.
+func newRemoveProjectMemberEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.RemoveProjectMember(ctx, request.(*service.RemoveProjectMemberRequest))
+	}
+}
+
+// newListProjectMembersEndpoint creates and returns a new endpoint for
+// ListProjectMembers use case.
+func newListProjectMembersEndpoint(svc service.ProjectService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListProjectMembers(ctx, request.(*service.ListProjectMembersRequest))
+	}
+}
@@ -0,0 +1,62 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// ReminderServiceEndpoints is a set of domain service.ReminderService's
+// endpoints.
+type ReminderServiceEndpoints struct {
+	ListRemindersEndpoint  endpoint.Endpoint
+	AddReminderEndpoint    endpoint.Endpoint
+	DeleteReminderEndpoint endpoint.Endpoint
+}
+
+// NewReminderServiceEndpoints creates and returns a new instance of
+// ReminderServiceEndpoints.
+func NewReminderServiceEndpoints(
+	svc service.ReminderService,
+	authClient golangkitauth.AuthenticateClient,
+) *ReminderServiceEndpoints {
+	epts := &ReminderServiceEndpoints{}
+
+	epts.ListRemindersEndpoint = newListRemindersEndpoint(svc)
+	epts.ListRemindersEndpoint = golangkitauth.Authenticate(authClient)(epts.ListRemindersEndpoint)
+
+	epts.AddReminderEndpoint = newAddReminderEndpoint(svc)
+	epts.AddReminderEndpoint = golangkitauth.Authenticate(authClient)(epts.AddReminderEndpoint)
+
+	epts.DeleteReminderEndpoint = newDeleteReminderEndpoint(svc)
+	epts.DeleteReminderEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteReminderEndpoint)
+
+	return epts
+}
+
+// newListRemindersEndpoint creates and returns a new endpoint for ListReminders
+// use case.
+func newListRemindersEndpoint(svc service.ReminderService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListReminders(ctx, request.(*service.ListRemindersRequest))
+	}
+}
+
+// newAddReminderEndpoint creates and returns a new endpoint for AddReminder use
+// case.
+func newAddReminderEndpoint(svc service.ReminderService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AddReminder(ctx, request.(*service.AddReminderRequest))
+	}
+}
+
+// newDeleteReminderEndpoint creates and returns a new endpoint for
+// DeleteReminder use case.
+func newDeleteReminderEndpoint(svc service.ReminderService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteReminder(ctx, request.(*service.DeleteReminderRequest))
+	}
+}
@@ -0,0 +1,75 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// ShareLinkServiceEndpoints is a set of domain service.ShareLinkService's
+// endpoints.
+type ShareLinkServiceEndpoints struct {
+	CreateShareLinkEndpoint endpoint.Endpoint
+	ListShareLinksEndpoint  endpoint.Endpoint
+	RevokeShareLinkEndpoint endpoint.Endpoint
+
+	GetSharedTaskEndpoint endpoint.Endpoint
+}
+
+// NewShareLinkServiceEndpoints creates and returns a new instance of
+// ShareLinkServiceEndpoints.
+func NewShareLinkServiceEndpoints(
+	svc service.ShareLinkService,
+	authClient golangkitauth.AuthenticateClient,
+) *ShareLinkServiceEndpoints {
+	epts := &ShareLinkServiceEndpoints{}
+
+	epts.CreateShareLinkEndpoint = newCreateShareLinkEndpoint(svc)
+	epts.CreateShareLinkEndpoint = golangkitauth.Authenticate(authClient)(epts.CreateShareLinkEndpoint)
+
+	epts.ListShareLinksEndpoint = newListShareLinksEndpoint(svc)
+	epts.ListShareLinksEndpoint = golangkitauth.Authenticate(authClient)(epts.ListShareLinksEndpoint)
+
+	epts.RevokeShareLinkEndpoint = newRevokeShareLinkEndpoint(svc)
+	epts.RevokeShareLinkEndpoint = golangkitauth.Authenticate(authClient)(epts.RevokeShareLinkEndpoint)
+
+	// Shared tasks are public, the token of the link is the only credential
+	epts.GetSharedTaskEndpoint = newGetSharedTaskEndpoint(svc)
+
+	return epts
+}
+
+// newCreateShareLinkEndpoint creates and returns a new endpoint for
+// CreateShareLink use case.
+func newCreateShareLinkEndpoint(svc service.ShareLinkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CreateShareLink(ctx, request.(*service.CreateShareLinkRequest))
+	}
+}
+
+// newListShareLinksEndpoint creates and returns a new endpoint for
+// ListShareLinks use case.
+func newListShareLinksEndpoint(svc service.ShareLinkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListShareLinks(ctx, request.(*service.ListShareLinksRequest))
+	}
+}
+
+// newRevokeShareLinkEndpoint creates and returns a new endpoint for
+// RevokeShareLink use case.
+func newRevokeShareLinkEndpoint(svc service.ShareLinkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.RevokeShareLink(ctx, request.(*service.RevokeShareLinkRequest))
+	}
+}
+
+// newGetSharedTaskEndpoint creates and returns a new endpoint for
+// GetSharedTask use case.
+func newGetSharedTaskEndpoint(svc service.ShareLinkService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.GetSharedTask(ctx, request.(*service.GetSharedTaskRequest))
+	}
+}
@@ -16,6 +16,37 @@ type TaskServiceEndpoints struct {
 	CreateNewTaskEndpoint endpoint.Endpoint
 	UpdateTaskEndpoint    endpoint.Endpoint
 	DeleteTaskEndpoint    endpoint.Endpoint
+
+	AddTaskLabelsEndpoint   endpoint.Endpoint
+	RemoveTaskLabelEndpoint endpoint.Endpoint
+
+	AssignTaskEndpoint   endpoint.Endpoint
+	UnassignTaskEndpoint endpoint.Endpoint
+
+	ListSubtasksEndpoint endpoint.Endpoint
+	MoveTaskEndpoint     endpoint.Endpoint
+
+	AddTaskDependencyEndpoint    endpoint.Endpoint
+	RemoveTaskDependencyEndpoint endpoint.Endpoint
+
+	ListProjectTasksEndpoint endpoint.Endpoint
+
+	ShareTaskEndpoint      endpoint.Endpoint
+	UnshareTaskEndpoint    endpoint.Endpoint
+	ListTaskSharesEndpoint endpoint.Endpoint
+
+	ListTaskActivitiesEndpoint endpoint.Endpoint
+
+	ListTrashEndpoint   endpoint.Endpoint
+	RestoreTaskEndpoint endpoint.Endpoint
+	PurgeTaskEndpoint   endpoint.Endpoint
+
+	ArchiveTaskEndpoint   endpoint.Endpoint
+	UnarchiveTaskEndpoint endpoint.Endpoint
+
+	ListTaskWatchersEndpoint endpoint.Endpoint
+	WatchTaskEndpoint        endpoint.Endpoint
+	UnwatchTaskEndpoint      endpoint.Endpoint
 }
 
 // NewTaskServiceEndpoints creates and returns a new instance of
@@ -41,6 +72,69 @@ func NewTaskServiceEndpoints(
 	epts.DeleteTaskEndpoint = newDeleteTaskEndpoint(svc)
 	epts.DeleteTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteTaskEndpoint)
 
+	epts.AddTaskLabelsEndpoint = newAddTaskLabelsEndpoint(svc)
+	epts.AddTaskLabelsEndpoint = golangkitauth.Authenticate(authClient)(epts.AddTaskLabelsEndpoint)
+
+	epts.RemoveTaskLabelEndpoint = newRemoveTaskLabelEndpoint(svc)
+	epts.RemoveTaskLabelEndpoint = golangkitauth.Authenticate(authClient)(epts.RemoveTaskLabelEndpoint)
+
+	epts.AssignTaskEndpoint = newAssignTaskEndpoint(svc)
+	epts.AssignTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.AssignTaskEndpoint)
+
+	epts.UnassignTaskEndpoint = newUnassignTaskEndpoint(svc)
+	epts.UnassignTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.UnassignTaskEndpoint)
+
+	epts.ListSubtasksEndpoint = newListSubtasksEndpoint(svc)
+	epts.ListSubtasksEndpoint = golangkitauth.Authenticate(authClient)(epts.ListSubtasksEndpoint)
+
+	epts.MoveTaskEndpoint = newMoveTaskEndpoint(svc)
+	epts.MoveTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.MoveTaskEndpoint)
+
+	epts.AddTaskDependencyEndpoint = newAddTaskDependencyEndpoint(svc)
+	epts.AddTaskDependencyEndpoint = golangkitauth.Authenticate(authClient)(epts.AddTaskDependencyEndpoint)
+
+	epts.RemoveTaskDependencyEndpoint = newRemoveTaskDependencyEndpoint(svc)
+	epts.RemoveTaskDependencyEndpoint = golangkitauth.Authenticate(authClient)(epts.RemoveTaskDependencyEndpoint)
+
+	epts.ListProjectTasksEndpoint = newListProjectTasksEndpoint(svc)
+	epts.ListProjectTasksEndpoint = golangkitauth.Authenticate(authClient)(epts.ListProjectTasksEndpoint)
+
+	epts.ShareTaskEndpoint = newShareTaskEndpoint(svc)
+	epts.ShareTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.ShareTaskEndpoint)
+
+	epts.UnshareTaskEndpoint = newUnshareTaskEndpoint(svc)
+	epts.UnshareTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.UnshareTaskEndpoint)
+
+	epts.ListTaskSharesEndpoint = newListTaskSharesEndpoint(svc)
+	epts.ListTaskSharesEndpoint = golangkitauth.Authenticate(authClient)(epts.ListTaskSharesEndpoint)
+
+	epts.ListTaskActivitiesEndpoint = newListTaskActivitiesEndpoint(svc)
+	epts.ListTaskActivitiesEndpoint = golangkitauth.Authenticate(authClient)(epts.ListTaskActivitiesEndpoint)
+
+	epts.ListTrashEndpoint = newListTrashEndpoint(svc)
+	epts.ListTrashEndpoint = golangkitauth.Authenticate(authClient)(epts.ListTrashEndpoint)
+
+	epts.RestoreTaskEndpoint = newRestoreTaskEndpoint(svc)
+	epts.RestoreTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.RestoreTaskEndpoint)
+
+	epts.PurgeTaskEndpoint = newPurgeTaskEndpoint(svc)
+	epts.PurgeTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.PurgeTaskEndpoint)
+
+	epts.ArchiveTaskEndpoint = newArchiveTaskEndpoint(svc)
+	epts.ArchiveTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.ArchiveTaskEndpoint)
+
+	epts.UnarchiveTaskEndpoint = newUnarchiveTaskEndpoint(svc)
+	epts.UnarchiveTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.UnarchiveTaskEndpoint)
+
+	epts.ListTaskWatchersEndpoint = newListTaskWatchersEndpoint(svc)
+	epts.ListTaskWatchersEndpoint = golangkitauth.Authenticate(authClient)(epts.ListTaskWatchersEndpoint)
+
+	epts.WatchTaskEndpoint = newWatchTaskEndpoint(svc)
+	epts.WatchTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.WatchTaskEndpoint)
+
+	epts.UnwatchTaskEndpoint = newUnwatchTaskEndpoint(svc)
+	epts.UnwatchTaskEndpoint = golangkitauth.Authenticate(authClient)(epts.UnwatchTaskEndpoint)
+
 	return epts
 }
 
@@ -83,3 +177,171 @@ func newDeleteTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
 		return svc.DeleteTask(ctx, request.(*service.DeleteTaskRequest))
 	}
 }
+
+// newAddTaskLabelsEndpoint creates and returns a new endpoint for
+// AddTaskLabels use case.
+func newAddTaskLabelsEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AddTaskLabels(ctx, request.(*service.AddTaskLabelsRequest))
+	}
+}
+
+// newRemoveTaskLabelEndpoint creates and returns a new endpoint for
+// RemoveTaskLabel use case.
+func newRemoveTaskLabelEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.RemoveTaskLabel(ctx, request.(*service.RemoveTaskLabelRequest))
+	}
+}
+
+// newAssignTaskEndpoint creates and returns a new endpoint for
+// AssignTask use case.
+func newAssignTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AssignTask(ctx, request.(*service.AssignTaskRequest))
+	}
+}
+
+// newUnassignTaskEndpoint creates and returns a new endpoint for
+// UnassignTask use case.
+func newUnassignTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UnassignTask(ctx, request.(*service.UnassignTaskRequest))
+	}
+}
+
+// newListSubtasksEndpoint creates and returns a new endpoint for
+// ListSubtasks use case.
+func newListSubtasksEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListSubtasks(ctx, request.(*service.ListSubtasksRequest))
+	}
+}
+
+// newMoveTaskEndpoint creates and returns a new endpoint for
+// MoveTask use case.
+func newMoveTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.MoveTask(ctx, request.(*service.MoveTaskRequest))
+	}
+}
+
+// newAddTaskDependencyEndpoint creates and returns a new endpoint for
+// AddTaskDependency use case.
+func newAddTaskDependencyEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AddTaskDependency(ctx, request.(*service.AddTaskDependencyRequest))
+	}
+}
+
+// newRemoveTaskDependencyEndpoint creates and returns a new endpoint for
+// RemoveTaskDependency use case.
+func newRemoveTaskDependencyEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.RemoveTaskDependency(ctx, request.(*service.RemoveTaskDependencyRequest))
+	}
+}
+
+// newListProjectTasksEndpoint creates and returns a new endpoint for
+// ListProjectTasks use case.
+func newListProjectTasksEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListProjectTasks(ctx, request.(*service.ListProjectTasksRequest))
+	}
+}
+
+// newShareTaskEndpoint creates and returns a new endpoint for
+// ShareTask use case.
+func newShareTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ShareTask(ctx, request.(*service.ShareTaskRequest))
+	}
+}
+
+// newUnshareTaskEndpoint creates and returns a new endpoint for
+// UnshareTask use case.
+func newUnshareTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UnshareTask(ctx, request.(*service.UnshareTaskRequest))
+	}
+}
+
+// newListTaskSharesEndpoint creates and returns a new endpoint for
+// ListTaskShares use case.
+func newListTaskSharesEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListTaskShares(ctx, request.(*service.ListTaskSharesRequest))
+	}
+}
+
+// newListTaskActivitiesEndpoint creates and returns a new endpoint for
+// ListTaskActivities use case.
+func newListTaskActivitiesEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListTaskActivities(ctx, request.(*service.ListTaskActivitiesRequest))
+	}
+}
+
+// newListTrashEndpoint creates and returns a new endpoint for
+// ListTrash use case.
+func newListTrashEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListTrash(ctx, request.(*service.ListTrashRequest))
+	}
+}
+
+// newRestoreTaskEndpoint creates and returns a new endpoint for
+// RestoreTask use case.
+func newRestoreTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.RestoreTask(ctx, request.(*service.RestoreTaskRequest))
+	}
+}
+
+// newPurgeTaskEndpoint creates and returns a new endpoint for
+// PurgeTask use case.
+func newPurgeTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.PurgeTask(ctx, request.(*service.PurgeTaskRequest))
+	}
+}
+
+// newArchiveTaskEndpoint creates and returns a new endpoint for
+// ArchiveTask use case.
+func newArchiveTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ArchiveTask(ctx, request.(*service.ArchiveTaskRequest))
+	}
+}
+
+// newUnarchiveTaskEndpoint creates and returns a new endpoint for
+// UnarchiveTask use case.
+func newUnarchiveTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UnarchiveTask(ctx, request.(*service.UnarchiveTaskRequest))
+	}
+}
+
+// newListTaskWatchersEndpoint creates and returns a new endpoint for
+// ListTaskWatchers use case.
+func newListTaskWatchersEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListTaskWatchers(ctx, request.(*service.ListTaskWatchersRequest))
+	}
+}
+
+// newWatchTaskEndpoint creates and returns a new endpoint for
+// WatchTask use case.
+func newWatchTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.WatchTask(ctx, request.(*service.WatchTaskRequest))
+	}
+}
+
+// newUnwatchTaskEndpoint creates and returns a new endpoint for
+// UnwatchTask use case.
+func newUnwatchTaskEndpoint(svc service.TaskService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UnwatchTask(ctx, request.(*service.UnwatchTaskRequest))
+	}
+}
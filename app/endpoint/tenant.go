@@ -0,0 +1,61 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// TenantServiceEndpoints is a set of domain service.TenantService's endpoints.
+type TenantServiceEndpoints struct {
+	AddTenantMemberEndpoint    endpoint.Endpoint
+	RemoveTenantMemberEndpoint endpoint.Endpoint
+	ListTenantMembersEndpoint  endpoint.Endpoint
+}
+
+// NewTenantServiceEndpoints creates and returns a new instance of
+// TenantServiceEndpoints.
+func NewTenantServiceEndpoints(
+	svc service.TenantService,
+	authClient golangkitauth.AuthenticateClient,
+) *TenantServiceEndpoints {
+	epts := &TenantServiceEndpoints{}
+
+	epts.AddTenantMemberEndpoint = newAddTenantMemberEndpoint(svc)
+	epts.AddTenantMemberEndpoint = golangkitauth.Authenticate(authClient)(epts.AddTenantMemberEndpoint)
+
+	epts.RemoveTenantMemberEndpoint = newRemoveTenantMemberEndpoint(svc)
+	epts.RemoveTenantMemberEndpoint = golangkitauth.Authenticate(authClient)(epts.RemoveTenantMemberEndpoint)
+
+	epts.ListTenantMembersEndpoint = newListTenantMembersEndpoint(svc)
+	epts.ListTenantMembersEndpoint = golangkitauth.Authenticate(authClient)(epts.ListTenantMembersEndpoint)
+
+	return epts
+}
+
+// newAddTenantMemberEndpoint creates and returns a new endpoint for
+// AddTenantMember use case.
+func newAddTenantMemberEndpoint(svc service.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.AddTenantMember(ctx, request.(*service.AddTenantMemberRequest))
+	}
+}
+
+// newRemoveTenantMemberEndpoint creates and returns a new endpoint for
+// RemoveTenantMember use case.
+func newRemoveTenantMemberEndpoint(svc service.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.RemoveTenantMember(ctx, request.(*service.RemoveTenantMemberRequest))
+	}
+}
+
+// newListTenantMembersEndpoint creates and returns a new endpoint for
+// ListTenantMembers use case.
+func newListTenantMembersEndpoint(svc service.TenantService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListTenantMembers(ctx, request.(*service.ListTenantMembersRequest))
+	}
+}
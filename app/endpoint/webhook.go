@@ -0,0 +1,98 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// WebhookServiceEndpoints is a set of domain service.WebhookService's
+// endpoints.
+type WebhookServiceEndpoints struct {
+	ListWebhooksEndpoint                endpoint.Endpoint
+	CreateWebhookEndpoint               endpoint.Endpoint
+	UpdateWebhookEndpoint               endpoint.Endpoint
+	DeleteWebhookEndpoint               endpoint.Endpoint
+	ListWebhookDeliveriesEndpoint       endpoint.Endpoint
+	ListWebhookDeliveryAttemptsEndpoint endpoint.Endpoint
+}
+
+// NewWebhookServiceEndpoints creates and returns a new instance of
+// WebhookServiceEndpoints.
+func NewWebhookServiceEndpoints(
+	svc service.WebhookService,
+	authClient golangkitauth.AuthenticateClient,
+) *WebhookServiceEndpoints {
+	epts := &WebhookServiceEndpoints{}
+
+	epts.ListWebhooksEndpoint = newListWebhooksEndpoint(svc)
+	epts.ListWebhooksEndpoint = golangkitauth.Authenticate(authClient)(epts.ListWebhooksEndpoint)
+
+	epts.CreateWebhookEndpoint = newCreateWebhookEndpoint(svc)
+	epts.CreateWebhookEndpoint = golangkitauth.Authenticate(authClient)(epts.CreateWebhookEndpoint)
+
+	epts.UpdateWebhookEndpoint = newUpdateWebhookEndpoint(svc)
+	epts.UpdateWebhookEndpoint = golangkitauth.Authenticate(authClient)(epts.UpdateWebhookEndpoint)
+
+	epts.DeleteWebhookEndpoint = newDeleteWebhookEndpoint(svc)
+	epts.DeleteWebhookEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteWebhookEndpoint)
+
+	epts.ListWebhookDeliveriesEndpoint = newListWebhookDeliveriesEndpoint(svc)
+	epts.ListWebhookDeliveriesEndpoint = golangkitauth.Authenticate(authClient)(epts.ListWebhookDeliveriesEndpoint)
+
+	epts.ListWebhookDeliveryAttemptsEndpoint = newListWebhookDeliveryAttemptsEndpoint(svc)
+	epts.ListWebhookDeliveryAttemptsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListWebhookDeliveryAttemptsEndpoint)
+
+	return epts
+}
+
+// newListWebhooksEndpoint creates and returns a new endpoint for ListWebhooks
+// use case.
+func newListWebhooksEndpoint(svc service.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListWebhooks(ctx, request.(*service.ListWebhooksRequest))
+	}
+}
+
+// newCreateWebhookEndpoint creates and returns a new endpoint for CreateWebhook
+// use case.
+func newCreateWebhookEndpoint(svc service.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CreateWebhook(ctx, request.(*service.CreateWebhookRequest))
+	}
+}
+
+// newUpdateWebhookEndpoint creates and returns a new endpoint for UpdateWebhook
+// use case.
+func newUpdateWebhookEndpoint(svc service.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UpdateWebhook(ctx, request.(*service.UpdateWebhookRequest))
+	}
+}
+
+// newDeleteWebhookEndpoint creates and returns a new endpoint for DeleteWebhook
+// use case.
+func newDeleteWebhookEndpoint(svc service.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteWebhook(ctx, request.(*service.DeleteWebhookRequest))
+	}
+}
+
+// newListWebhookDeliveriesEndpoint creates and returns a new endpoint for
+// ListWebhookDeliveries use case.
+func newListWebhookDeliveriesEndpoint(svc service.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListWebhookDeliveries(ctx, request.(*service.ListWebhookDeliveriesRequest))
+	}
+}
+
+// newListWebhookDeliveryAttemptsEndpoint creates and returns a new endpoint for
+// ListWebhookDeliveryAttempts use case.
+func newListWebhookDeliveryAttemptsEndpoint(svc service.WebhookService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListWebhookDeliveryAttempts(ctx, request.(*service.ListWebhookDeliveryAttemptsRequest))
+	}
+}
@@ -0,0 +1,86 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	golangkitauth "github.com/quocdaitrn/golang-kit/auth"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// WorkflowServiceEndpoints is a set of domain service.WorkflowService's
+// endpoints.
+type WorkflowServiceEndpoints struct {
+	ListWorkflowsEndpoint  endpoint.Endpoint
+	GetWorkflowEndpoint    endpoint.Endpoint
+	CreateWorkflowEndpoint endpoint.Endpoint
+	UpdateWorkflowEndpoint endpoint.Endpoint
+	DeleteWorkflowEndpoint endpoint.Endpoint
+}
+
+// NewWorkflowServiceEndpoints creates and returns a new instance of
+// WorkflowServiceEndpoints.
+func NewWorkflowServiceEndpoints(
+	svc service.WorkflowService,
+	authClient golangkitauth.AuthenticateClient,
+) *WorkflowServiceEndpoints {
+	epts := &WorkflowServiceEndpoints{}
+
+	epts.ListWorkflowsEndpoint = newListWorkflowsEndpoint(svc)
+	epts.ListWorkflowsEndpoint = golangkitauth.Authenticate(authClient)(epts.ListWorkflowsEndpoint)
+
+	epts.GetWorkflowEndpoint = newGetWorkflowEndpoint(svc)
+	epts.GetWorkflowEndpoint = golangkitauth.Authenticate(authClient)(epts.GetWorkflowEndpoint)
+
+	epts.CreateWorkflowEndpoint = newCreateWorkflowEndpoint(svc)
+	epts.CreateWorkflowEndpoint = golangkitauth.Authenticate(authClient)(epts.CreateWorkflowEndpoint)
+
+	epts.UpdateWorkflowEndpoint = newUpdateWorkflowEndpoint(svc)
+	epts.UpdateWorkflowEndpoint = golangkitauth.Authenticate(authClient)(epts.UpdateWorkflowEndpoint)
+
+	epts.DeleteWorkflowEndpoint = newDeleteWorkflowEndpoint(svc)
+	epts.DeleteWorkflowEndpoint = golangkitauth.Authenticate(authClient)(epts.DeleteWorkflowEndpoint)
+
+	return epts
+}
+
+// newListWorkflowsEndpoint creates and returns a new endpoint for
+// ListWorkflows use case.
+func newListWorkflowsEndpoint(svc service.WorkflowService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.ListWorkflows(ctx, request.(*service.ListWorkflowsRequest))
+	}
+}
+
+// newGetWorkflowEndpoint creates and returns a new endpoint for
+// GetWorkflow use case.
+func newGetWorkflowEndpoint(svc service.WorkflowService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.GetWorkflow(ctx, request.(*service.GetWorkflowRequest))
+	}
+}
+
+// newCreateWorkflowEndpoint creates and returns a new endpoint for
+// CreateWorkflow use case.
+func newCreateWorkflowEndpoint(svc service.WorkflowService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.CreateWorkflow(ctx, request.(*service.CreateWorkflowRequest))
+	}
+}
+
+// newUpdateWorkflowEndpoint creates and returns a new endpoint for
+// UpdateWorkflow use case.
+func newUpdateWorkflowEndpoint(svc service.WorkflowService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.UpdateWorkflow(ctx, request.(*service.UpdateWorkflowRequest))
+	}
+}
+
+// newDeleteWorkflowEndpoint creates and returns a new endpoint for
+// DeleteWorkflow use case.
+func newDeleteWorkflowEndpoint(svc service.WorkflowService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		return svc.DeleteWorkflow(ctx, request.(*service.DeleteWorkflowRequest))
+	}
+}
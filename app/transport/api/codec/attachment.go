@@ -0,0 +1,88 @@
+package codec
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeListAttachmentsRequest decodes ListAttachmentsRequest from
+// http.Request.
+func DecodeListAttachmentsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListAttachmentsRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUploadAttachmentRequest decodes UploadAttachmentRequest from a
+// multipart http.Request. Content of the file is not read here but streamed by
+// the service, so large files are never held in memory.
+func DecodeUploadAttachmentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UploadAttachmentRequest{TaskID: mux.Vars(r)["id"]}
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, kiterrors.WithStack(kiterrors.ErrRequestBindingFailed.WithDetails(err.Error()))
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"file": "file is required"})
+		}
+		if err != nil {
+			return nil, kiterrors.WithStack(kiterrors.ErrRequestBindingFailed.WithDetails(err.Error()))
+		}
+
+		if part.FormName() == "file" && part.FileName() != "" {
+			req.FileName = part.FileName()
+			req.File = part
+			return req, nil
+		}
+	}
+}
+
+// DecodeDownloadAttachmentRequest decodes DownloadAttachmentRequest from
+// http.Request.
+func DecodeDownloadAttachmentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DownloadAttachmentRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteAttachmentRequest decodes DeleteAttachmentRequest from
+// http.Request.
+func DecodeDeleteAttachmentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteAttachmentRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// EncodeDownloadAttachmentResponse writes content of an attachment to
+// http.ResponseWriter as a file download.
+func EncodeDownloadAttachmentResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	resp := response.(*service.DownloadAttachmentResponse)
+	defer resp.Content.Close()
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(resp.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	_, err := io.Copy(w, resp.Content)
+	return err
+}
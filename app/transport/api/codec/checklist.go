@@ -0,0 +1,60 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeListChecklistItemsRequest decodes ListChecklistItemsRequest from
+// http.Request.
+func DecodeListChecklistItemsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListChecklistItemsRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeAddChecklistItemRequest decodes AddChecklistItemRequest from
+// http.Request.
+func DecodeAddChecklistItemRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AddChecklistItemRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeReorderChecklistItemsRequest decodes ReorderChecklistItemsRequest from
+// http.Request.
+func DecodeReorderChecklistItemsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ReorderChecklistItemsRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUpdateChecklistItemRequest decodes UpdateChecklistItemRequest from
+// http.Request.
+func DecodeUpdateChecklistItemRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UpdateChecklistItemRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteChecklistItemRequest decodes DeleteChecklistItemRequest from
+// http.Request.
+func DecodeDeleteChecklistItemRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteChecklistItemRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,46 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeListCommentsRequest decodes ListCommentsRequest from http.Request.
+func DecodeListCommentsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListCommentsRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeCreateCommentRequest decodes CreateCommentRequest from http.Request.
+func DecodeCreateCommentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CreateCommentRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUpdateCommentRequest decodes UpdateCommentRequest from http.Request.
+func DecodeUpdateCommentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UpdateCommentRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteCommentRequest decodes DeleteCommentRequest from http.Request.
+func DecodeDeleteCommentRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteCommentRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,55 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeGetLabelRequest decodes GetLabelRequest from http.Request.
+func DecodeGetLabelRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.GetLabelRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListLabelsRequest decodes ListLabelsRequest from http.Request.
+func DecodeListLabelsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListLabelsRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeCreateLabelRequest decodes CreateLabelRequest from http.Request.
+func DecodeCreateLabelRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CreateLabelRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUpdateLabelRequest decodes UpdateLabelRequest from http.Request.
+func DecodeUpdateLabelRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UpdateLabelRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteLabelRequest decodes DeleteLabelRequest from http.Request.
+func DecodeDeleteLabelRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteLabelRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
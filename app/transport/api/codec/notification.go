@@ -0,0 +1,50 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeListNotificationsRequest decodes ListNotificationsRequest from
+// http.Request.
+func DecodeListNotificationsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListNotificationsRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeCountUnreadNotificationsRequest decodes CountUnreadNotificationsRequest
+// from http.Request.
+func DecodeCountUnreadNotificationsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CountUnreadNotificationsRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeMarkAllNotificationsReadRequest decodes MarkAllNotificationsReadRequest
+// from http.Request.
+func DecodeMarkAllNotificationsReadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.MarkAllNotificationsReadRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeMarkNotificationReadRequest decodes MarkNotificationReadRequest from
+// http.Request.
+func DecodeMarkNotificationReadRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.MarkNotificationReadRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
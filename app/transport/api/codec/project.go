@@ -0,0 +1,94 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeGetProjectRequest decodes GetProjectRequest from http.Request.
+func DecodeGetProjectRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.GetProjectRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListProjectsRequest decodes ListProjectsRequest from http.Request.
+func DecodeListProjectsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListProjectsRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeCreateProjectRequest decodes CreateProjectRequest from http.Request.
+func DecodeCreateProjectRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CreateProjectRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUpdateProjectRequest decodes UpdateProjectRequest from http.Request.
+func DecodeUpdateProjectRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UpdateProjectRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteProjectRequest decodes DeleteProjectRequest from http.Request.
+func DecodeDeleteProjectRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteProjectRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeArchiveProjectRequest decodes ArchiveProjectRequest from http.Request.
+func DecodeArchiveProjectRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ArchiveProjectRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeAddProjectMemberRequest decodes AddProjectMemberRequest from
+// http.Request.
+func DecodeAddProjectMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AddProjectMemberRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeRemoveProjectMemberRequest decodes RemoveProjectMemberRequest from
+// http.Request.
+func DecodeRemoveProjectMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.RemoveProjectMemberRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListProjectMembersRequest decodes ListProjectMembersRequest from
+// http.Request.
+func DecodeListProjectMembersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListProjectMembersRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
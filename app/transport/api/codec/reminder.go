@@ -0,0 +1,37 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeListRemindersRequest decodes ListRemindersRequest from http.Request.
+func DecodeListRemindersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListRemindersRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeAddReminderRequest decodes AddReminderRequest from http.Request.
+func DecodeAddReminderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AddReminderRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteReminderRequest decodes DeleteReminderRequest from http.Request.
+func DecodeDeleteReminderRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteReminderRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,62 @@
+package codec
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeCreateShareLinkRequest decodes CreateShareLinkRequest from
+// http.Request.
+func DecodeCreateShareLinkRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CreateShareLinkRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListShareLinksRequest decodes ListShareLinksRequest from http.Request.
+func DecodeListShareLinksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListShareLinksRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeRevokeShareLinkRequest decodes RevokeShareLinkRequest from
+// http.Request.
+func DecodeRevokeShareLinkRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.RevokeShareLinkRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeGetSharedTaskRequest decodes GetSharedTaskRequest from http.Request,
+// along with infos of the client to log the access.
+func DecodeGetSharedTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.GetSharedTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	req.RemoteAddr = remoteAddr(r)
+	req.UserAgent = r.UserAgent()
+	return req, nil
+}
+
+// remoteAddr returns address of the client connection. Forwarding headers are
+// ignored since any client can set them.
+func remoteAddr(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+
+	return r.RemoteAddr
+}
@@ -53,3 +53,198 @@ func DecodeDeleteTaskRequest(_ context.Context, r *http.Request) (interface{}, e
 	}
 	return req, nil
 }
+
+// DecodeAddTaskLabelsRequest decodes AddTaskLabelsRequest from http.Request.
+func DecodeAddTaskLabelsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AddTaskLabelsRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeRemoveTaskLabelRequest decodes RemoveTaskLabelRequest from
+// http.Request.
+func DecodeRemoveTaskLabelRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.RemoveTaskLabelRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeAssignTaskRequest decodes AssignTaskRequest from http.Request.
+func DecodeAssignTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AssignTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUnassignTaskRequest decodes UnassignTaskRequest from http.Request.
+func DecodeUnassignTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UnassignTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListSubtasksRequest decodes ListSubtasksRequest from http.Request.
+func DecodeListSubtasksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListSubtasksRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeMoveTaskRequest decodes MoveTaskRequest from http.Request.
+func DecodeMoveTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.MoveTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeAddTaskDependencyRequest decodes AddTaskDependencyRequest from
+// http.Request.
+func DecodeAddTaskDependencyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AddTaskDependencyRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeRemoveTaskDependencyRequest decodes RemoveTaskDependencyRequest from
+// http.Request.
+func DecodeRemoveTaskDependencyRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.RemoveTaskDependencyRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListProjectTasksRequest decodes ListProjectTasksRequest from
+// http.Request.
+func DecodeListProjectTasksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListProjectTasksRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeShareTaskRequest decodes ShareTaskRequest from http.Request.
+func DecodeShareTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ShareTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUnshareTaskRequest decodes UnshareTaskRequest from http.Request.
+func DecodeUnshareTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UnshareTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListTaskSharesRequest decodes ListTaskSharesRequest from http.Request.
+func DecodeListTaskSharesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListTaskSharesRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListTaskActivitiesRequest decodes ListTaskActivitiesRequest from
+// http.Request.
+func DecodeListTaskActivitiesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListTaskActivitiesRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListTrashRequest decodes ListTrashRequest from http.Request.
+func DecodeListTrashRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListTrashRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeRestoreTaskRequest decodes RestoreTaskRequest from http.Request.
+func DecodeRestoreTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.RestoreTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodePurgeTaskRequest decodes PurgeTaskRequest from http.Request.
+func DecodePurgeTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.PurgeTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeArchiveTaskRequest decodes ArchiveTaskRequest from http.Request.
+func DecodeArchiveTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ArchiveTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUnarchiveTaskRequest decodes UnarchiveTaskRequest from http.Request.
+func DecodeUnarchiveTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UnarchiveTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListTaskWatchersRequest decodes ListTaskWatchersRequest from
+// http.Request.
+func DecodeListTaskWatchersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListTaskWatchersRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeWatchTaskRequest decodes WatchTaskRequest from http.Request.
+func DecodeWatchTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.WatchTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUnwatchTaskRequest decodes UnwatchTaskRequest from http.Request.
+func DecodeUnwatchTaskRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UnwatchTaskRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,40 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeAddTenantMemberRequest decodes AddTenantMemberRequest from
+// http.Request.
+func DecodeAddTenantMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.AddTenantMemberRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeRemoveTenantMemberRequest decodes RemoveTenantMemberRequest from
+// http.Request.
+func DecodeRemoveTenantMemberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.RemoveTenantMemberRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListTenantMembersRequest decodes ListTenantMembersRequest from
+// http.Request.
+func DecodeListTenantMembersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListTenantMembersRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,66 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeListWebhooksRequest decodes ListWebhooksRequest from http.Request.
+func DecodeListWebhooksRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListWebhooksRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeCreateWebhookRequest decodes CreateWebhookRequest from http.Request.
+func DecodeCreateWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CreateWebhookRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUpdateWebhookRequest decodes UpdateWebhookRequest from http.Request.
+func DecodeUpdateWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UpdateWebhookRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteWebhookRequest decodes DeleteWebhookRequest from http.Request.
+func DecodeDeleteWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteWebhookRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListWebhookDeliveriesRequest decodes ListWebhookDeliveriesRequest from
+// http.Request.
+func DecodeListWebhookDeliveriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListWebhookDeliveriesRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListWebhookDeliveryAttemptsRequest decodes
+// ListWebhookDeliveryAttemptsRequest from http.Request.
+func DecodeListWebhookDeliveryAttemptsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListWebhookDeliveryAttemptsRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
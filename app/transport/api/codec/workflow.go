@@ -0,0 +1,55 @@
+package codec
+
+import (
+	"context"
+	"net/http"
+
+	kithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// DecodeGetWorkflowRequest decodes GetWorkflowRequest from http.Request.
+func DecodeGetWorkflowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.GetWorkflowRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeListWorkflowsRequest decodes ListWorkflowsRequest from http.Request.
+func DecodeListWorkflowsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.ListWorkflowsRequest{Limit: 20}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeCreateWorkflowRequest decodes CreateWorkflowRequest from http.Request.
+func DecodeCreateWorkflowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.CreateWorkflowRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeUpdateWorkflowRequest decodes UpdateWorkflowRequest from http.Request.
+func DecodeUpdateWorkflowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.UpdateWorkflowRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DecodeDeleteWorkflowRequest decodes DeleteWorkflowRequest from http.Request.
+func DecodeDeleteWorkflowRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := &service.DeleteWorkflowRequest{}
+	if err := kithttp.Bind(r, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
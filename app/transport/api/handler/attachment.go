@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeAttachmentHTTPHandler provides all attachment's routes.
+func MakeAttachmentHTTPHandler(
+	r *mux.Router,
+	svc service.AttachmentService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	attachmentSvcEpts := endpoint.NewAttachmentServiceEndpoints(svc, authClient)
+
+	listAttachmentsHandler := kithttp.NewServer(
+		attachmentSvcEpts.ListAttachmentsEndpoint,
+		codec.DecodeListAttachmentsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	uploadAttachmentHandler := kithttp.NewServer(
+		attachmentSvcEpts.UploadAttachmentEndpoint,
+		codec.DecodeUploadAttachmentRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	downloadAttachmentHandler := kithttp.NewServer(
+		attachmentSvcEpts.DownloadAttachmentEndpoint,
+		codec.DecodeDownloadAttachmentRequest,
+		codec.EncodeDownloadAttachmentResponse,
+		opts...,
+	)
+
+	deleteAttachmentHandler := kithttp.NewServer(
+		attachmentSvcEpts.DeleteAttachmentEndpoint,
+		codec.DecodeDeleteAttachmentRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/tasks/{id}/attachments", listAttachmentsHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/attachments", uploadAttachmentHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/attachments/{attachment_id}", downloadAttachmentHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/attachments/{attachment_id}", deleteAttachmentHandler).Methods(http.MethodDelete)
+
+	return r
+}
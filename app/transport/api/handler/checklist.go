@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeChecklistHTTPHandler provides all checklist's routes.
+func MakeChecklistHTTPHandler(
+	r *mux.Router,
+	svc service.ChecklistService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	checklistSvcEpts := endpoint.NewChecklistServiceEndpoints(svc, authClient)
+
+	listChecklistItemsHandler := kithttp.NewServer(
+		checklistSvcEpts.ListChecklistItemsEndpoint,
+		codec.DecodeListChecklistItemsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	addChecklistItemHandler := kithttp.NewServer(
+		checklistSvcEpts.AddChecklistItemEndpoint,
+		codec.DecodeAddChecklistItemRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	reorderChecklistItemsHandler := kithttp.NewServer(
+		checklistSvcEpts.ReorderChecklistItemsEndpoint,
+		codec.DecodeReorderChecklistItemsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	updateChecklistItemHandler := kithttp.NewServer(
+		checklistSvcEpts.UpdateChecklistItemEndpoint,
+		codec.DecodeUpdateChecklistItemRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteChecklistItemHandler := kithttp.NewServer(
+		checklistSvcEpts.DeleteChecklistItemEndpoint,
+		codec.DecodeDeleteChecklistItemRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/tasks/{id}/checklist", listChecklistItemsHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/checklist", addChecklistItemHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/checklist/order", reorderChecklistItemsHandler).Methods(http.MethodPut)
+	r.Handle("/tasks/{id}/checklist/{item_id}", updateChecklistItemHandler).Methods(http.MethodPatch)
+	r.Handle("/tasks/{id}/checklist/{item_id}", deleteChecklistItemHandler).Methods(http.MethodDelete)
+
+	return r
+}
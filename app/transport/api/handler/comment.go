@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeCommentHTTPHandler provides all comment's routes.
+func MakeCommentHTTPHandler(
+	r *mux.Router,
+	svc service.CommentService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	commentSvcEpts := endpoint.NewCommentServiceEndpoints(svc, authClient)
+
+	listCommentsHandler := kithttp.NewServer(
+		commentSvcEpts.ListCommentsEndpoint,
+		codec.DecodeListCommentsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	createCommentHandler := kithttp.NewServer(
+		commentSvcEpts.CreateCommentEndpoint,
+		codec.DecodeCreateCommentRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	updateCommentHandler := kithttp.NewServer(
+		commentSvcEpts.UpdateCommentEndpoint,
+		codec.DecodeUpdateCommentRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteCommentHandler := kithttp.NewServer(
+		commentSvcEpts.DeleteCommentEndpoint,
+		codec.DecodeDeleteCommentRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/tasks/{id}/comments", listCommentsHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/comments", createCommentHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/comments/{comment_id}", updateCommentHandler).Methods(http.MethodPatch)
+	r.Handle("/tasks/{id}/comments/{comment_id}", deleteCommentHandler).Methods(http.MethodDelete)
+
+	return r
+}
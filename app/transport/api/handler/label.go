@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeLabelHTTPHandler provides all label's routes.
+func MakeLabelHTTPHandler(
+	r *mux.Router,
+	svc service.LabelService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	labelSvcEpts := endpoint.NewLabelServiceEndpoints(svc, authClient)
+
+	getLabelHandler := kithttp.NewServer(
+		labelSvcEpts.GetLabelEndpoint,
+		codec.DecodeGetLabelRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listLabelsHandler := kithttp.NewServer(
+		labelSvcEpts.ListLabelsEndpoint,
+		codec.DecodeListLabelsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	createLabelHandler := kithttp.NewServer(
+		labelSvcEpts.CreateLabelEndpoint,
+		codec.DecodeCreateLabelRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	updateLabelHandler := kithttp.NewServer(
+		labelSvcEpts.UpdateLabelEndpoint,
+		codec.DecodeUpdateLabelRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteLabelHandler := kithttp.NewServer(
+		labelSvcEpts.DeleteLabelEndpoint,
+		codec.DecodeDeleteLabelRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/labels/{id}", getLabelHandler).Methods(http.MethodGet)
+	r.Handle("/labels", listLabelsHandler).Methods(http.MethodGet)
+	r.Handle("/labels", createLabelHandler).Methods(http.MethodPost)
+	r.Handle("/labels/{id}", updateLabelHandler).Methods(http.MethodPatch)
+	r.Handle("/labels/{id}", deleteLabelHandler).Methods(http.MethodDelete)
+
+	return r
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeNotificationHTTPHandler provides all notification's routes.
+func MakeNotificationHTTPHandler(
+	r *mux.Router,
+	svc service.NotificationService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	notificationSvcEpts := endpoint.NewNotificationServiceEndpoints(svc, authClient)
+
+	listNotificationsHandler := kithttp.NewServer(
+		notificationSvcEpts.ListNotificationsEndpoint,
+		codec.DecodeListNotificationsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	countUnreadNotificationsHandler := kithttp.NewServer(
+		notificationSvcEpts.CountUnreadNotificationsEndpoint,
+		codec.DecodeCountUnreadNotificationsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	markAllNotificationsReadHandler := kithttp.NewServer(
+		notificationSvcEpts.MarkAllNotificationsReadEndpoint,
+		codec.DecodeMarkAllNotificationsReadRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	markNotificationReadHandler := kithttp.NewServer(
+		notificationSvcEpts.MarkNotificationReadEndpoint,
+		codec.DecodeMarkNotificationReadRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/notifications", listNotificationsHandler).Methods(http.MethodGet)
+	r.Handle("/notifications/unread-count", countUnreadNotificationsHandler).Methods(http.MethodGet)
+	r.Handle("/notifications/read-all", markAllNotificationsReadHandler).Methods(http.MethodPost)
+	r.Handle("/notifications/{id}/read", markNotificationReadHandler).Methods(http.MethodPost)
+
+	return r
+}
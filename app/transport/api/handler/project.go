@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeProjectHTTPHandler provides all project's routes.
+func MakeProjectHTTPHandler(
+	r *mux.Router,
+	svc service.ProjectService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	projectSvcEpts := endpoint.NewProjectServiceEndpoints(svc, authClient)
+
+	getProjectHandler := kithttp.NewServer(
+		projectSvcEpts.GetProjectEndpoint,
+		codec.DecodeGetProjectRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listProjectsHandler := kithttp.NewServer(
+		projectSvcEpts.ListProjectsEndpoint,
+		codec.DecodeListProjectsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	createProjectHandler := kithttp.NewServer(
+		projectSvcEpts.CreateProjectEndpoint,
+		codec.DecodeCreateProjectRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	updateProjectHandler := kithttp.NewServer(
+		projectSvcEpts.UpdateProjectEndpoint,
+		codec.DecodeUpdateProjectRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteProjectHandler := kithttp.NewServer(
+		projectSvcEpts.DeleteProjectEndpoint,
+		codec.DecodeDeleteProjectRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	archiveProjectHandler := kithttp.NewServer(
+		projectSvcEpts.ArchiveProjectEndpoint,
+		codec.DecodeArchiveProjectRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	addProjectMemberHandler := kithttp.NewServer(
+		projectSvcEpts.AddProjectMemberEndpoint,
+		codec.DecodeAddProjectMemberRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	removeProjectMemberHandler := kithttp.NewServer(
+		projectSvcEpts.RemoveProjectMemberEndpoint,
+		codec.DecodeRemoveProjectMemberRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listProjectMembersHandler := kithttp.NewServer(
+		projectSvcEpts.ListProjectMembersEndpoint,
+		codec.DecodeListProjectMembersRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/projects/{id}", getProjectHandler).Methods(http.MethodGet)
+	r.Handle("/projects", listProjectsHandler).Methods(http.MethodGet)
+	r.Handle("/projects", createProjectHandler).Methods(http.MethodPost)
+	r.Handle("/projects/{id}", updateProjectHandler).Methods(http.MethodPatch)
+	r.Handle("/projects/{id}", deleteProjectHandler).Methods(http.MethodDelete)
+	r.Handle("/projects/{id}/archive", archiveProjectHandler).Methods(http.MethodPost)
+	r.Handle("/projects/{id}/members", addProjectMemberHandler).Methods(http.MethodPost)
+	r.Handle("/projects/{id}/members/{user_id}", removeProjectMemberHandler).Methods(http.MethodDelete)
+	r.Handle("/projects/{id}/members", listProjectMembersHandler).Methods(http.MethodGet)
+
+	return r
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeReminderHTTPHandler provides all reminder's routes.
+func MakeReminderHTTPHandler(
+	r *mux.Router,
+	svc service.ReminderService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	reminderSvcEpts := endpoint.NewReminderServiceEndpoints(svc, authClient)
+
+	listRemindersHandler := kithttp.NewServer(
+		reminderSvcEpts.ListRemindersEndpoint,
+		codec.DecodeListRemindersRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	addReminderHandler := kithttp.NewServer(
+		reminderSvcEpts.AddReminderEndpoint,
+		codec.DecodeAddReminderRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteReminderHandler := kithttp.NewServer(
+		reminderSvcEpts.DeleteReminderEndpoint,
+		codec.DecodeDeleteReminderRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/tasks/{id}/reminders", listRemindersHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/reminders", addReminderHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/reminders/{reminder_id}", deleteReminderHandler).Methods(http.MethodDelete)
+
+	return r
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeShareLinkHTTPHandler provides all share link's routes.
+func MakeShareLinkHTTPHandler(
+	r *mux.Router,
+	svc service.ShareLinkService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	shareLinkSvcEpts := endpoint.NewShareLinkServiceEndpoints(svc, authClient)
+
+	createShareLinkHandler := kithttp.NewServer(
+		shareLinkSvcEpts.CreateShareLinkEndpoint,
+		codec.DecodeCreateShareLinkRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listShareLinksHandler := kithttp.NewServer(
+		shareLinkSvcEpts.ListShareLinksEndpoint,
+		codec.DecodeListShareLinksRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	revokeShareLinkHandler := kithttp.NewServer(
+		shareLinkSvcEpts.RevokeShareLinkEndpoint,
+		codec.DecodeRevokeShareLinkRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	getSharedTaskHandler := kithttp.NewServer(
+		shareLinkSvcEpts.GetSharedTaskEndpoint,
+		codec.DecodeGetSharedTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/tasks/{id}/links", createShareLinkHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/links", listShareLinksHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/links/{link_id}", revokeShareLinkHandler).Methods(http.MethodDelete)
+	r.Handle("/shared/tasks/{token}", getSharedTaskHandler).Methods(http.MethodGet)
+
+	return r
+}
@@ -65,11 +65,179 @@ func MakeTaskHTTPHandler(
 		opts...,
 	)
 
+	addTaskLabelsHandler := kithttp.NewServer(
+		taskSvcEpts.AddTaskLabelsEndpoint,
+		codec.DecodeAddTaskLabelsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	removeTaskLabelHandler := kithttp.NewServer(
+		taskSvcEpts.RemoveTaskLabelEndpoint,
+		codec.DecodeRemoveTaskLabelRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	assignTaskHandler := kithttp.NewServer(
+		taskSvcEpts.AssignTaskEndpoint,
+		codec.DecodeAssignTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	unassignTaskHandler := kithttp.NewServer(
+		taskSvcEpts.UnassignTaskEndpoint,
+		codec.DecodeUnassignTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listSubtasksHandler := kithttp.NewServer(
+		taskSvcEpts.ListSubtasksEndpoint,
+		codec.DecodeListSubtasksRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	moveTaskHandler := kithttp.NewServer(
+		taskSvcEpts.MoveTaskEndpoint,
+		codec.DecodeMoveTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	addTaskDependencyHandler := kithttp.NewServer(
+		taskSvcEpts.AddTaskDependencyEndpoint,
+		codec.DecodeAddTaskDependencyRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	removeTaskDependencyHandler := kithttp.NewServer(
+		taskSvcEpts.RemoveTaskDependencyEndpoint,
+		codec.DecodeRemoveTaskDependencyRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listProjectTasksHandler := kithttp.NewServer(
+		taskSvcEpts.ListProjectTasksEndpoint,
+		codec.DecodeListProjectTasksRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	shareTaskHandler := kithttp.NewServer(
+		taskSvcEpts.ShareTaskEndpoint,
+		codec.DecodeShareTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	unshareTaskHandler := kithttp.NewServer(
+		taskSvcEpts.UnshareTaskEndpoint,
+		codec.DecodeUnshareTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listTaskSharesHandler := kithttp.NewServer(
+		taskSvcEpts.ListTaskSharesEndpoint,
+		codec.DecodeListTaskSharesRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listTaskActivitiesHandler := kithttp.NewServer(
+		taskSvcEpts.ListTaskActivitiesEndpoint,
+		codec.DecodeListTaskActivitiesRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listTrashHandler := kithttp.NewServer(
+		taskSvcEpts.ListTrashEndpoint,
+		codec.DecodeListTrashRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	restoreTaskHandler := kithttp.NewServer(
+		taskSvcEpts.RestoreTaskEndpoint,
+		codec.DecodeRestoreTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	purgeTaskHandler := kithttp.NewServer(
+		taskSvcEpts.PurgeTaskEndpoint,
+		codec.DecodePurgeTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	archiveTaskHandler := kithttp.NewServer(
+		taskSvcEpts.ArchiveTaskEndpoint,
+		codec.DecodeArchiveTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	unarchiveTaskHandler := kithttp.NewServer(
+		taskSvcEpts.UnarchiveTaskEndpoint,
+		codec.DecodeUnarchiveTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listTaskWatchersHandler := kithttp.NewServer(
+		taskSvcEpts.ListTaskWatchersEndpoint,
+		codec.DecodeListTaskWatchersRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	watchTaskHandler := kithttp.NewServer(
+		taskSvcEpts.WatchTaskEndpoint,
+		codec.DecodeWatchTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	unwatchTaskHandler := kithttp.NewServer(
+		taskSvcEpts.UnwatchTaskEndpoint,
+		codec.DecodeUnwatchTaskRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
 	r.Handle("/tasks/{id}", getTaskHandler).Methods(http.MethodGet)
 	r.Handle("/tasks", listTasksHandler).Methods(http.MethodGet)
 	r.Handle("/tasks", createTaskHandler).Methods(http.MethodPost)
 	r.Handle("/tasks/{id}", updateTaskHandler).Methods(http.MethodPatch)
 	r.Handle("/tasks/{id}", deleteTaskHandler).Methods(http.MethodDelete)
+	r.Handle("/tasks/{id}/labels", addTaskLabelsHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/labels/{label_id}", removeTaskLabelHandler).Methods(http.MethodDelete)
+	r.Handle("/tasks/{id}/assignees", assignTaskHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/assignees/{user_id}", unassignTaskHandler).Methods(http.MethodDelete)
+	r.Handle("/tasks/{id}/subtasks", listSubtasksHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/parent", moveTaskHandler).Methods(http.MethodPut)
+	r.Handle("/tasks/{id}/dependencies", addTaskDependencyHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/dependencies/{blocker_id}", removeTaskDependencyHandler).Methods(http.MethodDelete)
+	r.Handle("/projects/{id}/tasks", listProjectTasksHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/shares", shareTaskHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/shares/{user_id}", unshareTaskHandler).Methods(http.MethodDelete)
+	r.Handle("/tasks/{id}/shares", listTaskSharesHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/activity", listTaskActivitiesHandler).Methods(http.MethodGet)
+	r.Handle("/trash/tasks", listTrashHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/restore", restoreTaskHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/purge", purgeTaskHandler).Methods(http.MethodDelete)
+	r.Handle("/tasks/{id}/archive", archiveTaskHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/unarchive", unarchiveTaskHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/watchers", listTaskWatchersHandler).Methods(http.MethodGet)
+	r.Handle("/tasks/{id}/watchers", watchTaskHandler).Methods(http.MethodPost)
+	r.Handle("/tasks/{id}/watchers", unwatchTaskHandler).Methods(http.MethodDelete)
 
 	return r
 }
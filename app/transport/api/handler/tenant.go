@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeTenantHTTPHandler provides all tenant's routes.
+func MakeTenantHTTPHandler(
+	r *mux.Router,
+	svc service.TenantService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	tenantSvcEpts := endpoint.NewTenantServiceEndpoints(svc, authClient)
+
+	addTenantMemberHandler := kithttp.NewServer(
+		tenantSvcEpts.AddTenantMemberEndpoint,
+		codec.DecodeAddTenantMemberRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	removeTenantMemberHandler := kithttp.NewServer(
+		tenantSvcEpts.RemoveTenantMemberEndpoint,
+		codec.DecodeRemoveTenantMemberRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listTenantMembersHandler := kithttp.NewServer(
+		tenantSvcEpts.ListTenantMembersEndpoint,
+		codec.DecodeListTenantMembersRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/tenant/members", addTenantMemberHandler).Methods(http.MethodPost)
+	r.Handle("/tenant/members/{user_id}", removeTenantMemberHandler).Methods(http.MethodDelete)
+	r.Handle("/tenant/members", listTenantMembersHandler).Methods(http.MethodGet)
+
+	return r
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeWebhookHTTPHandler provides all webhook's routes.
+func MakeWebhookHTTPHandler(
+	r *mux.Router,
+	svc service.WebhookService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	webhookSvcEpts := endpoint.NewWebhookServiceEndpoints(svc, authClient)
+
+	listWebhooksHandler := kithttp.NewServer(
+		webhookSvcEpts.ListWebhooksEndpoint,
+		codec.DecodeListWebhooksRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	createWebhookHandler := kithttp.NewServer(
+		webhookSvcEpts.CreateWebhookEndpoint,
+		codec.DecodeCreateWebhookRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	updateWebhookHandler := kithttp.NewServer(
+		webhookSvcEpts.UpdateWebhookEndpoint,
+		codec.DecodeUpdateWebhookRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteWebhookHandler := kithttp.NewServer(
+		webhookSvcEpts.DeleteWebhookEndpoint,
+		codec.DecodeDeleteWebhookRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listWebhookDeliveriesHandler := kithttp.NewServer(
+		webhookSvcEpts.ListWebhookDeliveriesEndpoint,
+		codec.DecodeListWebhookDeliveriesRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listWebhookDeliveryAttemptsHandler := kithttp.NewServer(
+		webhookSvcEpts.ListWebhookDeliveryAttemptsEndpoint,
+		codec.DecodeListWebhookDeliveryAttemptsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/webhooks", listWebhooksHandler).Methods(http.MethodGet)
+	r.Handle("/webhooks", createWebhookHandler).Methods(http.MethodPost)
+	r.Handle("/webhooks/{id}", updateWebhookHandler).Methods(http.MethodPatch)
+	r.Handle("/webhooks/{id}", deleteWebhookHandler).Methods(http.MethodDelete)
+	r.Handle("/webhooks/{id}/deliveries", listWebhookDeliveriesHandler).Methods(http.MethodGet)
+	r.Handle("/webhooks/{id}/deliveries/{delivery_id}/attempts", listWebhookDeliveryAttemptsHandler).Methods(http.MethodGet)
+
+	return r
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"github.com/quocdaitrn/golang-kit/auth"
+	golangkithttp "github.com/quocdaitrn/golang-kit/http"
+
+	"github.com/quocdaitrn/cp-task/app/endpoint"
+	"github.com/quocdaitrn/cp-task/app/transport/api/codec"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// MakeWorkflowHTTPHandler provides all workflow's routes.
+func MakeWorkflowHTTPHandler(
+	r *mux.Router,
+	svc service.WorkflowService,
+	logger log.Logger,
+	authClient auth.AuthenticateClient,
+) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(golangkithttp.DefaultErrorEncoder),
+		kithttp.ServerBefore(golangkithttp.PopulateRequestAuthorizationToken),
+	}
+
+	workflowSvcEpts := endpoint.NewWorkflowServiceEndpoints(svc, authClient)
+
+	getWorkflowHandler := kithttp.NewServer(
+		workflowSvcEpts.GetWorkflowEndpoint,
+		codec.DecodeGetWorkflowRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	listWorkflowsHandler := kithttp.NewServer(
+		workflowSvcEpts.ListWorkflowsEndpoint,
+		codec.DecodeListWorkflowsRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	createWorkflowHandler := kithttp.NewServer(
+		workflowSvcEpts.CreateWorkflowEndpoint,
+		codec.DecodeCreateWorkflowRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	updateWorkflowHandler := kithttp.NewServer(
+		workflowSvcEpts.UpdateWorkflowEndpoint,
+		codec.DecodeUpdateWorkflowRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	deleteWorkflowHandler := kithttp.NewServer(
+		workflowSvcEpts.DeleteWorkflowEndpoint,
+		codec.DecodeDeleteWorkflowRequest,
+		golangkithttp.EncodeResponse,
+		opts...,
+	)
+
+	r.Handle("/workflows/{id}", getWorkflowHandler).Methods(http.MethodGet)
+	r.Handle("/workflows", listWorkflowsHandler).Methods(http.MethodGet)
+	r.Handle("/workflows", createWorkflowHandler).Methods(http.MethodPost)
+	r.Handle("/workflows/{id}", updateWorkflowHandler).Methods(http.MethodPatch)
+	r.Handle("/workflows/{id}", deleteWorkflowHandler).Methods(http.MethodDelete)
+
+	return r
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// ActivityAction is the kind of change recorded by an activity.
+type ActivityAction string
+
+const (
+	// ActivityActionCreated records creation of a task.
+	ActivityActionCreated ActivityAction = "created"
+	// ActivityActionUpdated records change of a field of a task.
+	ActivityActionUpdated ActivityAction = "updated"
+	// ActivityActionStatusChanged records change of status of a task.
+	ActivityActionStatusChanged ActivityAction = "status_changed"
+	// ActivityActionDeleted records deletion of a task.
+	ActivityActionDeleted ActivityAction = "deleted"
+	// ActivityActionRestored records restoration of a deleted task.
+	ActivityActionRestored ActivityAction = "restored"
+	// ActivityActionArchived records archiving of a task.
+	ActivityActionArchived ActivityAction = "archived"
+	// ActivityActionUnarchived records unarchiving of a task.
+	ActivityActionUnarchived ActivityAction = "unarchived"
+)
+
+// Activity defines data model for an entry of the history of a task. Entries
+// are only appended, never changed.
+type Activity struct {
+	ID        uint           `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID    *core.UID      `json:"id" gorm:"-"`
+	TaskID    uint           `json:"-" gorm:"column:task_id"`
+	UserID    uint           `json:"-" gorm:"column:user_id"`
+	Actor     *SimpleUser    `json:"actor" gorm:"-"`
+	Action    ActivityAction `json:"action" gorm:"column:action"`
+	Field     string         `json:"field,omitempty" gorm:"column:field"`
+	OldValue  *string        `json:"old_value,omitempty" gorm:"column:old_value"`
+	NewValue  *string        `json:"new_value,omitempty" gorm:"column:new_value"`
+	CreatedAt time.Time      `json:"created_at" gorm:"column:created_at;autocreatetime"`
+}
+
+func (Activity) TableName() string { return "task_activities" }
+
+func (a *Activity) Mask() {
+	uid := core.NewUID(uint32(a.ID), MaskTypeActivity, 1)
+	a.FakeID = &uid
+
+	if u := a.Actor; u != nil {
+		u.Mask()
+	}
+}
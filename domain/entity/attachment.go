@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// Attachment defines data model for metadata of a file attached to a task.
+// Content of the file is kept in a blob storage under StorageKey.
+type Attachment struct {
+	ID          uint        `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID      *core.UID   `json:"id" gorm:"-"`
+	TaskID      uint        `json:"-" gorm:"column:task_id"`
+	UserID      uint        `json:"-" gorm:"column:user_id"`
+	User        *SimpleUser `json:"user" gorm:"-"`
+	FileName    string      `json:"file_name" gorm:"column:file_name"`
+	ContentType string      `json:"content_type" gorm:"column:content_type"`
+	Size        int64       `json:"size" gorm:"column:size"`
+	StorageKey  string      `json:"-" gorm:"column:storage_key"`
+	CreatedAt   time.Time   `json:"created_at" gorm:"column:created_at;autocreatetime"`
+}
+
+func (Attachment) TableName() string { return "task_attachments" }
+
+func (a *Attachment) Mask() {
+	uid := core.NewUID(uint32(a.ID), MaskTypeAttachment, 1)
+	a.FakeID = &uid
+
+	if u := a.User; u != nil {
+		u.Mask()
+	}
+}
+
+// AttachmentPolicy limits files which can be attached to tasks.
+type AttachmentPolicy struct {
+	// MaxSize is the largest size of a file in bytes.
+	MaxSize int64
+	// AllowedTypes lists media types of files which can be attached. A type
+	// may have a wildcard subtype, e.g. "image/*".
+	AllowedTypes []string
+}
+
+// AllowsType reports whether files of the given media type can be attached.
+func (p AttachmentPolicy) AllowsType(mediaType string) bool {
+	for _, allowed := range p.AllowedTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType {
+			return true
+		}
+
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
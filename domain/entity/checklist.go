@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// ChecklistItem defines data model for a step of the checklist of a task.
+// Items are ordered by their position within the task.
+type ChecklistItem struct {
+	ID        uint      `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID    *core.UID `json:"id" gorm:"-"`
+	TaskID    uint      `json:"-" gorm:"column:task_id"`
+	Text      string    `json:"text" gorm:"column:text"`
+	Checked   bool      `json:"checked" gorm:"column:checked"`
+	Position  int       `json:"position" gorm:"column:position"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (ChecklistItem) TableName() string { return "task_checklist_items" }
+
+func (i *ChecklistItem) Mask() {
+	uid := core.NewUID(uint32(i.ID), MaskTypeChecklistItem, 1)
+	i.FakeID = &uid
+}
+
+// ChecklistStats summarizes progress of the checklist of a task.
+type ChecklistStats struct {
+	Total   int64 `json:"total"`
+	Checked int64 `json:"checked"`
+}
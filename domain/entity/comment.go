@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// Comment defines data model for a comment on a task. Comments are threaded
+// one level deep: replies always belong to a root comment.
+type Comment struct {
+	ID           uint        `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID       *core.UID   `json:"id" gorm:"-"`
+	TaskID       uint        `json:"-" gorm:"column:task_id"`
+	UserID       uint        `json:"-" gorm:"column:user_id"`
+	User         *SimpleUser `json:"user" gorm:"-"`
+	ParentID     *uint       `json:"-" gorm:"column:parent_id"`
+	FakeParentID *core.UID   `json:"parent_id" gorm:"-"`
+	Body         string      `json:"body" gorm:"column:body"`
+	Replies      []Comment   `json:"replies,omitempty" gorm:"-"`
+	CreatedAt    time.Time   `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt    time.Time   `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (Comment) TableName() string { return "task_comments" }
+
+func (c *Comment) Mask() {
+	uid := core.NewUID(uint32(c.ID), MaskTypeComment, 1)
+	c.FakeID = &uid
+
+	if c.ParentID != nil {
+		parentUID := core.NewUID(uint32(*c.ParentID), MaskTypeComment, 1)
+		c.FakeParentID = &parentUID
+	}
+
+	if u := c.User; u != nil {
+		u.Mask()
+	}
+
+	for i := range c.Replies {
+		c.Replies[i].Mask()
+	}
+}
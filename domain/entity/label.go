@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// Label defines data model for label which can be attached to tasks.
+type Label struct {
+	ID        uint      `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID    *core.UID `json:"id" gorm:"-"`
+	UserID    uint      `json:"-" gorm:"column:user_id"`
+	Name      string    `json:"name" gorm:"column:name"`
+	Color     string    `json:"color" gorm:"column:color"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (Label) TableName() string { return "labels" }
+
+func (l *Label) Mask() {
+	uid := core.NewUID(uint32(l.ID), MaskTypeLabel, 1)
+	l.FakeID = &uid
+}
+
+// TaskLabel defines data model for association between task and label.
+type TaskLabel struct {
+	TaskID    uint      `gorm:"primary_key;column:task_id"`
+	LabelID   uint      `gorm:"primary_key;column:label_id"`
+	CreatedAt time.Time `gorm:"column:created_at;autocreatetime"`
+}
+
+func (TaskLabel) TableName() string { return "task_labels" }
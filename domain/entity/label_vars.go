@@ -0,0 +1,16 @@
+package entity
+
+// LabelFilter condition to filter labels.
+type LabelFilter struct {
+	UserID *uint `json:"user_id,omitempty"`
+}
+
+// LabelMatch defines how tasks are matched against a list of labels.
+type LabelMatch string
+
+const (
+	// LabelMatchAny matches tasks having at least one of the labels.
+	LabelMatchAny LabelMatch = "any"
+	// LabelMatchAll matches tasks having all the labels.
+	LabelMatchAll LabelMatch = "all"
+)
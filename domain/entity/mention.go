@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"regexp"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// mentionPattern matches mentions of users in texts, written as "@" followed
+// by masked id of the user.
+var mentionPattern = regexp.MustCompile(`@([1-9A-HJ-NP-Za-km-z]+)`)
+
+// Mention defines data model for an user mentioned in description of a task,
+// or in one of its comments if CommentID is set.
+type Mention struct {
+	TaskID    uint  `gorm:"column:task_id"`
+	CommentID *uint `gorm:"column:comment_id"`
+	UserID    uint  `gorm:"column:user_id"`
+}
+
+func (Mention) TableName() string { return "task_mentions" }
+
+// ParseMentions returns ids of users mentioned in text, without duplicates.
+// Mentions which are not masked ids of users are skipped.
+func ParseMentions(text string) []uint {
+	ids := make([]uint, 0)
+	seen := make(map[uint]bool)
+
+	for _, m := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		uid, err := core.FromBase58(m[1])
+		if err != nil || uid.GetObjectType() != MaskTypeUser {
+			continue
+		}
+
+		id := uint(uid.GetLocalID())
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids
+}
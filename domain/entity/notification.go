@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// NotificationType is the kind of event a notification tells about.
+type NotificationType string
+
+const (
+	// NotificationTypeAssigned tells user was assigned to a task.
+	NotificationTypeAssigned NotificationType = "assigned"
+	// NotificationTypeMentioned tells user was mentioned in a task or in one
+	// of its comments.
+	NotificationTypeMentioned NotificationType = "mentioned"
+	// NotificationTypeCommented tells a comment was posted on a task.
+	NotificationTypeCommented NotificationType = "commented"
+	// NotificationTypeStatusChanged tells status of a task changed.
+	NotificationTypeStatusChanged NotificationType = "status_changed"
+	// NotificationTypeReminder tells a reminder set on a task fired.
+	NotificationTypeReminder NotificationType = "reminder"
+)
+
+// Notification defines data model for an entry of the inbox of an user. A
+// notification fired by a reminder keeps id of the reminder, so each reminder
+// is recorded once per user however many times it is fired.
+type Notification struct {
+	ID         uint             `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID     *core.UID        `json:"id" gorm:"-"`
+	TenantID   string           `json:"-" gorm:"column:tenant_id"`
+	UserID     uint             `json:"-" gorm:"column:user_id;uniqueIndex:uniq_notification_reminder_user"`
+	TaskID     uint             `json:"-" gorm:"column:task_id"`
+	FakeTaskID *core.UID        `json:"task_id" gorm:"-"`
+	ActorID    *uint            `json:"-" gorm:"column:actor_id"`
+	ReminderID *uint            `json:"-" gorm:"column:reminder_id;uniqueIndex:uniq_notification_reminder_user"`
+	Actor      *SimpleUser      `json:"actor" gorm:"-"`
+	Type       NotificationType `json:"type" gorm:"column:type"`
+	Title      string           `json:"title" gorm:"column:title"`
+	Body       string           `json:"body" gorm:"column:body"`
+	ReadAt     *time.Time       `json:"read_at" gorm:"column:read_at"`
+	CreatedAt  time.Time        `json:"created_at" gorm:"column:created_at;autocreatetime"`
+}
+
+func (Notification) TableName() string { return "notifications" }
+
+func (n *Notification) Mask() {
+	uid := core.NewUID(uint32(n.ID), MaskTypeNotification, 1)
+	n.FakeID = &uid
+
+	taskUID := core.NewUID(uint32(n.TaskID), MaskTypeTask, 1)
+	n.FakeTaskID = &taskUID
+
+	if u := n.Actor; u != nil {
+		u.Mask()
+	}
+}
+
+// NotificationFilter defines conditions for listing notifications.
+type NotificationFilter struct {
+	UserID     uint              `json:"user_id"`
+	UnreadOnly bool              `json:"unread_only,omitempty"`
+	Type       *NotificationType `json:"type,omitempty"`
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+type ProjectStatus string
+
+const (
+	// ProjectStatusActive indicates project is in use.
+	ProjectStatusActive ProjectStatus = "active"
+	// ProjectStatusArchived indicates project is archived.
+	ProjectStatusArchived ProjectStatus = "archived"
+	// ProjectStatusDeleted indicates project is deleted.
+	ProjectStatusDeleted ProjectStatus = "deleted"
+)
+
+// Project defines data model for project, a container of tasks.
+type Project struct {
+	ID             uint          `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID         *core.UID     `json:"id" gorm:"-"`
+	TenantID       string        `json:"-" gorm:"column:tenant_id"`
+	UserID         uint          `json:"-" gorm:"column:user_id"`
+	Name           string        `json:"name" gorm:"column:name"`
+	Description    string        `json:"description" gorm:"column:description"`
+	Status         ProjectStatus `json:"status" gorm:"column:status"`
+	WorkflowID     *uint         `json:"-" gorm:"column:workflow_id"`
+	FakeWorkflowID *core.UID     `json:"workflow_id" gorm:"-"`
+	CreatedAt      time.Time     `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt      time.Time     `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (Project) TableName() string { return "projects" }
+
+func (p *Project) Mask() {
+	uid := core.NewUID(uint32(p.ID), MaskTypeProject, 1)
+	p.FakeID = &uid
+
+	if p.WorkflowID != nil {
+		workflowUID := core.NewUID(uint32(*p.WorkflowID), MaskTypeWorkflow, 1)
+		p.FakeWorkflowID = &workflowUID
+	}
+}
@@ -0,0 +1,9 @@
+package entity
+
+// ProjectFilter condition to filter projects.
+type ProjectFilter struct {
+	UserID *uint `json:"user_id,omitempty"`
+	// MemberID restricts projects to the ones an user owns or has a role on.
+	MemberID *uint          `json:"member_id,omitempty"`
+	Status   *ProjectStatus `json:"status,omitempty"`
+}
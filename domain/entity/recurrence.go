@@ -0,0 +1,282 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is how often a recurring task repeats.
+type Frequency string
+
+const (
+	// FrequencyDaily repeats a task every day.
+	FrequencyDaily Frequency = "DAILY"
+	// FrequencyWeekly repeats a task every week.
+	FrequencyWeekly Frequency = "WEEKLY"
+	// FrequencyMonthly repeats a task every month.
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+// recurrencePresets maps shorthand names of recurrences to their rules.
+var recurrencePresets = map[string]string{
+	"daily":   "FREQ=DAILY",
+	"weekly":  "FREQ=WEEKLY",
+	"monthly": "FREQ=MONTHLY",
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// maxRecurrenceSteps bounds the search for the next occurrence, so rules that
+// never match (e.g. the 31st of every other February) can not loop forever.
+const maxRecurrenceSteps = 1000
+
+// RecurrenceRule is a subset of RFC 5545 RRULE supporting FREQ (DAILY, WEEKLY,
+// MONTHLY), INTERVAL, BYDAY (weekly only), BYMONTHDAY (monthly only), COUNT and
+// UNTIL. Weeks start on Monday.
+type RecurrenceRule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+// ParseRecurrenceRule parses a rule written as RRULE, with or without the
+// "RRULE:" prefix, or as one of the shorthands daily, weekly and monthly.
+func ParseRecurrenceRule(text string) (*RecurrenceRule, error) {
+	text = strings.TrimSpace(text)
+	if preset, ok := recurrencePresets[strings.ToLower(text)]; ok {
+		text = preset
+	}
+	text = strings.TrimPrefix(strings.ToUpper(text), "RRULE:")
+
+	rule := &RecurrenceRule{Interval: 1}
+
+	for _, part := range strings.Split(text, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+
+		switch name {
+		case "FREQ":
+			rule.Freq = Frequency(value)
+			if rule.Freq != FrequencyDaily && rule.Freq != FrequencyWeekly && rule.Freq != FrequencyMonthly {
+				return nil, fmt.Errorf("unsupported recurrence frequency %q", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid recurrence interval %q", value)
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				weekday, ok := weekdayNames[d]
+				if !ok {
+					return nil, fmt.Errorf("invalid recurrence weekday %q", d)
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(value, ",") {
+				day, err := strconv.Atoi(d)
+				if err != nil || day == 0 || day < -31 || day > 31 {
+					return nil, fmt.Errorf("invalid recurrence month day %q", d)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, day)
+			}
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid recurrence count %q", value)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part %q", name)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("recurrence frequency is required")
+	}
+
+	if len(rule.ByDay) > 0 && rule.Freq != FrequencyWeekly {
+		return nil, fmt.Errorf("BYDAY is only supported with weekly frequency")
+	}
+
+	if len(rule.ByMonthDay) > 0 && rule.Freq != FrequencyMonthly {
+		return nil, fmt.Errorf("BYMONTHDAY is only supported with monthly frequency")
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL can not be used together")
+	}
+
+	return rule, nil
+}
+
+// parseRecurrenceUntil parses UNTIL as a UTC date-time or as a date, which
+// includes the whole day.
+func parseRecurrenceUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid recurrence until %q", value)
+}
+
+// String returns the rule in RRULE format, without the "RRULE:" prefix.
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			for name, weekday := range weekdayNames {
+				if weekday == d {
+					days[i] = name
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Next returns the occurrence following prev, which is the index-th
+// occurrence of the series (starting from 1). It reports false when the
+// series has ended. Time of day of prev is kept.
+func (r *RecurrenceRule) Next(prev time.Time, index int) (time.Time, bool) {
+	if r.Count > 0 && index >= r.Count {
+		return time.Time{}, false
+	}
+
+	var next time.Time
+	var ok bool
+
+	switch r.Freq {
+	case FrequencyDaily:
+		next, ok = prev.AddDate(0, 0, r.Interval), true
+	case FrequencyWeekly:
+		next, ok = r.nextWeekly(prev)
+	case FrequencyMonthly:
+		next, ok = r.nextMonthly(prev)
+	}
+
+	if !ok || (r.Until != nil && next.After(*r.Until)) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+// nextWeekly returns the next listed weekday later in the week of prev, or
+// the first listed weekday of the week interval weeks later.
+func (r *RecurrenceRule) nextWeekly(prev time.Time) (time.Time, bool) {
+	if len(r.ByDay) == 0 {
+		return prev.AddDate(0, 0, 7*r.Interval), true
+	}
+
+	offsets := make([]int, len(r.ByDay))
+	for i, d := range r.ByDay {
+		offsets[i] = weekdayOffset(d)
+	}
+	sort.Ints(offsets)
+
+	current := weekdayOffset(prev.Weekday())
+	for _, offset := range offsets {
+		if offset > current {
+			return prev.AddDate(0, 0, offset-current), true
+		}
+	}
+
+	weekStart := prev.AddDate(0, 0, -current)
+	return weekStart.AddDate(0, 0, 7*r.Interval+offsets[0]), true
+}
+
+// nextMonthly returns the next listed day later in the month of prev, or the
+// first listed day of a month interval months later. Months lacking every
+// listed day are skipped.
+func (r *RecurrenceRule) nextMonthly(prev time.Time) (time.Time, bool) {
+	days := r.ByMonthDay
+	if len(days) == 0 {
+		days = []int{prev.Day()}
+	}
+
+	year, month, _ := prev.Date()
+	hour, min, sec := prev.Clock()
+
+	for step := 0; step < maxRecurrenceSteps; step++ {
+		first := time.Date(year, month+time.Month(step*r.Interval), 1, hour, min, sec, prev.Nanosecond(), prev.Location())
+		last := first.AddDate(0, 1, -1).Day()
+
+		candidates := make([]int, 0, len(days))
+		for _, d := range days {
+			if d < 0 {
+				d = last + d + 1
+			}
+			if d >= 1 && d <= last {
+				candidates = append(candidates, d)
+			}
+		}
+		sort.Ints(candidates)
+
+		for _, d := range candidates {
+			next := first.AddDate(0, 0, d-1)
+			if next.After(prev) {
+				return next, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// weekdayOffset returns number of days from Monday to weekday d.
+func weekdayOffset(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
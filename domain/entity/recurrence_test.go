@@ -0,0 +1,265 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+// date returns the given day at 10:00 UTC.
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{text: "daily", want: "FREQ=DAILY"},
+		{text: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR"},
+		{text: "FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=3", want: "FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=3"},
+		{text: "FREQ=DAILY;UNTIL=20240105", want: "FREQ=DAILY;UNTIL=20240105T235959Z"},
+		{text: "FREQ=YEARLY", wantErr: true},
+		{text: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{text: "FREQ=DAILY;BYDAY=MO", wantErr: true},
+		{text: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{text: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: true},
+		{text: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{text: "FREQ=MONTHLY;BYMONTHDAY=-32", wantErr: true},
+		{text: "FREQ=DAILY;COUNT=2;UNTIL=20240105", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.text)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("rule = %q, want error", rule)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parse rule: %v", err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("rule = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Next(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   string
+		prev   time.Time
+		index  int
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "daily with interval",
+			rule:   "FREQ=DAILY;INTERVAL=2",
+			prev:   date(2024, time.January, 1),
+			index:  1,
+			want:   date(2024, time.January, 3),
+			wantOK: true,
+		},
+		{
+			name:   "weekly with interval",
+			rule:   "FREQ=WEEKLY;INTERVAL=2",
+			prev:   date(2024, time.January, 1),
+			index:  1,
+			want:   date(2024, time.January, 15),
+			wantOK: true,
+		},
+		{
+			name:   "weekly by day later in the week",
+			rule:   "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			prev:   date(2024, time.January, 1),
+			index:  1,
+			want:   date(2024, time.January, 3),
+			wantOK: true,
+		},
+		{
+			name:   "weekly by day in the next week",
+			rule:   "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			prev:   date(2024, time.January, 5),
+			index:  1,
+			want:   date(2024, time.January, 8),
+			wantOK: true,
+		},
+		{
+			name:   "weekly by day from sunday",
+			rule:   "FREQ=WEEKLY;BYDAY=TU",
+			prev:   date(2024, time.January, 7),
+			index:  1,
+			want:   date(2024, time.January, 9),
+			wantOK: true,
+		},
+		{
+			name:   "weekly by day with interval",
+			rule:   "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			prev:   date(2024, time.January, 3),
+			index:  1,
+			want:   date(2024, time.January, 15),
+			wantOK: true,
+		},
+		{
+			name:   "monthly by month day later in the month",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=1,15",
+			prev:   date(2024, time.January, 10),
+			index:  1,
+			want:   date(2024, time.January, 15),
+			wantOK: true,
+		},
+		{
+			name:   "monthly by negative month day",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=-1",
+			prev:   date(2024, time.January, 31),
+			index:  1,
+			want:   date(2024, time.February, 29),
+			wantOK: true,
+		},
+		{
+			name:   "monthly skips february lacking the 31st",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=31",
+			prev:   date(2024, time.January, 31),
+			index:  1,
+			want:   date(2024, time.March, 31),
+			wantOK: true,
+		},
+		{
+			name:   "monthly keeps day of previous occurrence",
+			rule:   "FREQ=MONTHLY",
+			prev:   date(2023, time.January, 30),
+			index:  1,
+			want:   date(2023, time.March, 30),
+			wantOK: true,
+		},
+		{
+			name:   "monthly never matching gives up",
+			rule:   "FREQ=MONTHLY;INTERVAL=12;BYMONTHDAY=30",
+			prev:   date(2024, time.February, 1),
+			index:  1,
+			wantOK: false,
+		},
+		{
+			name:   "count not reached",
+			rule:   "FREQ=DAILY;COUNT=3",
+			prev:   date(2024, time.January, 2),
+			index:  2,
+			want:   date(2024, time.January, 3),
+			wantOK: true,
+		},
+		{
+			name:   "count reached",
+			rule:   "FREQ=DAILY;COUNT=3",
+			prev:   date(2024, time.January, 3),
+			index:  3,
+			wantOK: false,
+		},
+		{
+			name:   "until includes its whole day",
+			rule:   "FREQ=DAILY;UNTIL=20240105",
+			prev:   date(2024, time.January, 4),
+			index:  1,
+			want:   date(2024, time.January, 5),
+			wantOK: true,
+		},
+		{
+			name:   "until passed",
+			rule:   "FREQ=DAILY;UNTIL=20240105",
+			prev:   date(2024, time.January, 5),
+			index:  1,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rule)
+			if err != nil {
+				t.Fatalf("parse rule: %v", err)
+			}
+
+			got, ok := rule.Next(tt.prev, tt.index)
+			if ok != tt.wantOK {
+				t.Fatalf("next = %v, %v, want ok %v", got, ok, tt.wantOK)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("next = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTask_ScheduleRecurrenceSkipsMissedOccurrences(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   string
+		dueAt  time.Time
+		now    time.Time
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "next occurrence ahead",
+			rule:   "FREQ=DAILY",
+			dueAt:  date(2024, time.January, 10),
+			now:    date(2024, time.January, 10).Add(-time.Hour),
+			want:   date(2024, time.January, 11),
+			wantOK: true,
+		},
+		{
+			name:   "missed occurrences skipped",
+			rule:   "FREQ=DAILY",
+			dueAt:  date(2024, time.January, 1),
+			now:    date(2024, time.January, 10),
+			want:   date(2024, time.January, 11),
+			wantOK: true,
+		},
+		{
+			name:   "missed occurrences do not count",
+			rule:   "FREQ=WEEKLY;BYDAY=MO;COUNT=2",
+			dueAt:  date(2024, time.January, 1),
+			now:    date(2024, time.March, 1),
+			want:   date(2024, time.March, 4),
+			wantOK: true,
+		},
+		{
+			name:   "series ended while missed",
+			rule:   "FREQ=DAILY;UNTIL=20240105",
+			dueAt:  date(2024, time.January, 1),
+			now:    date(2024, time.January, 10),
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{Recurrence: &tt.rule, DueAt: &tt.dueAt}
+
+			if err := task.ScheduleRecurrence(tt.now); err != nil {
+				t.Fatalf("schedule recurrence: %v", err)
+			}
+
+			if task.OccurrenceAt == nil || !task.OccurrenceAt.Equal(tt.dueAt) {
+				t.Errorf("occurrence at = %v, want %v", task.OccurrenceAt, tt.dueAt)
+			}
+
+			if !tt.wantOK {
+				if task.NextOccurrenceAt != nil {
+					t.Errorf("next occurrence at = %v, want none", task.NextOccurrenceAt)
+				}
+				return
+			}
+
+			if task.NextOccurrenceAt == nil || !task.NextOccurrenceAt.Equal(tt.want) {
+				t.Errorf("next occurrence at = %v, want %v", task.NextOccurrenceAt, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// Reminder defines data model for a reminder an user set on a task. It fires
+// either at an absolute time, or some minutes before due date of the task, in
+// which case it follows the due date when it changes. A reminder fires once.
+type Reminder struct {
+	ID               uint       `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID           *core.UID  `json:"id" gorm:"-"`
+	TaskID           uint       `json:"-" gorm:"column:task_id"`
+	Task             *Task      `json:"-" gorm:"-"`
+	UserID           uint       `json:"-" gorm:"column:user_id"`
+	BeforeDueMinutes *int       `json:"before_due_minutes,omitempty" gorm:"column:before_due_minutes"`
+	RemindAt         *time.Time `json:"remind_at" gorm:"column:remind_at"`
+	LockedUntil      *time.Time `json:"-" gorm:"column:locked_until"`
+	FiredAt          *time.Time `json:"fired_at" gorm:"column:fired_at"`
+	CreatedAt        time.Time  `json:"created_at" gorm:"column:created_at;autocreatetime"`
+}
+
+func (Reminder) TableName() string { return "task_reminders" }
+
+func (r *Reminder) Mask() {
+	uid := core.NewUID(uint32(r.ID), MaskTypeReminder, 1)
+	r.FakeID = &uid
+}
+
+// Schedule computes when a reminder relative to due date fires, given due
+// date of its task. Reminder of a task without due date never fires.
+// Absolute reminders are left untouched.
+func (r *Reminder) Schedule(dueAt *time.Time) {
+	if r.BeforeDueMinutes == nil {
+		return
+	}
+
+	if dueAt == nil {
+		r.RemindAt = nil
+		return
+	}
+
+	remindAt := dueAt.Add(-time.Duration(*r.BeforeDueMinutes) * time.Minute)
+	r.RemindAt = &remindAt
+}
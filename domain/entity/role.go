@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// Role is a set of permissions granted to an user, on a project or on whole
+// tenant.
+type Role string
+
+const (
+	// RoleViewer can only see tasks.
+	RoleViewer Role = "viewer"
+	// RoleEditor can see and change tasks.
+	RoleEditor Role = "editor"
+	// RoleAdmin can do anything, including deleting tasks and managing members.
+	RoleAdmin Role = "admin"
+)
+
+// roleRanks orders roles, a role includes all permissions of lower ranked
+// roles.
+var roleRanks = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// Includes reports whether role grants at least permissions of other role.
+func (r Role) Includes(other Role) bool {
+	rank, ok := roleRanks[r]
+	if !ok {
+		return false
+	}
+
+	return rank >= roleRanks[other]
+}
+
+// MaxRole returns the highest ranked role of roles, or empty role if there is
+// none.
+func MaxRole(roles ...Role) Role {
+	var max Role
+
+	for _, r := range roles {
+		if roleRanks[r] > roleRanks[max] {
+			max = r
+		}
+	}
+
+	return max
+}
+
+// Action is an operation on a task or a project which requires a role.
+type Action string
+
+const (
+	// ActionView sees a task or a project.
+	ActionView Action = "view"
+	// ActionEdit changes a task, or adds tasks to a project.
+	ActionEdit Action = "edit"
+	// ActionDelete deletes a task or a project.
+	ActionDelete Action = "delete"
+	// ActionManage manages a task or a project and who can access it.
+	ActionManage Action = "manage"
+)
+
+// actionRoles maps each action to the lowest role allowed to do it.
+var actionRoles = map[Action]Role{
+	ActionView:   RoleViewer,
+	ActionEdit:   RoleEditor,
+	ActionDelete: RoleAdmin,
+	ActionManage: RoleAdmin,
+}
+
+// RequiredRole returns the lowest role allowed to do action.
+func (a Action) RequiredRole() Role {
+	if role, ok := actionRoles[a]; ok {
+		return role
+	}
+
+	return RoleAdmin
+}
+
+// RoleBinding defines data model for a role granted to an user. Binding
+// without project applies to all tasks of the tenant. An user has at most one
+// binding per project and one on whole tenant, ProjectKey stands for the
+// project in the unique index since NULL project never conflicts.
+type RoleBinding struct {
+	ID            uint        `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	TenantID      string      `json:"-" gorm:"column:tenant_id;size:64;uniqueIndex:uniq_role_binding,priority:1"`
+	ProjectID     *uint       `json:"-" gorm:"column:project_id"`
+	ProjectKey    uint        `json:"-" gorm:"column:project_key;not null;default:0;uniqueIndex:uniq_role_binding,priority:2"`
+	FakeProjectID *core.UID   `json:"project_id,omitempty" gorm:"-"`
+	UserID        uint        `json:"-" gorm:"column:user_id;uniqueIndex:uniq_role_binding,priority:3"`
+	User          *SimpleUser `json:"user" gorm:"-"`
+	Role          Role        `json:"role" gorm:"column:role"`
+	CreatedAt     time.Time   `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt     time.Time   `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (RoleBinding) TableName() string { return "role_bindings" }
+
+func (b *RoleBinding) Mask() {
+	if b.ProjectID != nil {
+		projectUID := core.NewUID(uint32(*b.ProjectID), MaskTypeProject, 1)
+		b.FakeProjectID = &projectUID
+	}
+
+	if u := b.User; u != nil {
+		u.Mask()
+	}
+}
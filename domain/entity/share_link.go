@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// ShareLink defines data model for a public link giving read-only access to a
+// task to anyone holding its token. Only hash of the token is stored.
+type ShareLink struct {
+	ID         uint       `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID     *core.UID  `json:"id" gorm:"-"`
+	TenantID   string     `json:"-" gorm:"column:tenant_id"`
+	TaskID     uint       `json:"-" gorm:"column:task_id"`
+	UserID     uint       `json:"-" gorm:"column:user_id"`
+	TokenHash  string     `json:"-" gorm:"column:token_hash"`
+	ExpiresAt  *time.Time `json:"expires_at" gorm:"column:expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"column:revoked_at"`
+	AccessedAt *time.Time `json:"accessed_at" gorm:"column:accessed_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"column:created_at;autocreatetime"`
+}
+
+func (ShareLink) TableName() string { return "task_share_links" }
+
+func (l *ShareLink) Mask() {
+	uid := core.NewUID(uint32(l.ID), MaskTypeShareLink, 1)
+	l.FakeID = &uid
+}
+
+// IsActive reports whether link can still be used at the given time.
+func (l *ShareLink) IsActive(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+
+	return l.ExpiresAt == nil || now.Before(*l.ExpiresAt)
+}
+
+// SharedTask defines the public view of a task read through a share link. It
+// carries no internal ids and nothing but masked id of the owner.
+type SharedTask struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      Status     `json:"status"`
+	StartAt     *time.Time `json:"start_at"`
+	DueAt       *time.Time `json:"due_at"`
+	Overdue     bool       `json:"overdue"`
+	OwnerID     *core.UID  `json:"owner_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// NewSharedTask builds the public view of task at the given time.
+func NewSharedTask(task *Task, now time.Time) *SharedTask {
+	ownerUID := core.NewUID(uint32(task.UserID), MaskTypeUser, 1)
+
+	return &SharedTask{
+		Title:       task.Title,
+		Description: task.Description,
+		Status:      task.Status,
+		StartAt:     task.StartAt,
+		DueAt:       task.DueAt,
+		Overdue:     task.IsOverdue(now),
+		OwnerID:     &ownerUID,
+		CreatedAt:   task.CreatedAt,
+		UpdatedAt:   task.UpdatedAt,
+	}
+}
+
+// ShareLinkAccess defines data model for an access to a task through a share
+// link.
+type ShareLinkAccess struct {
+	ID         uint      `gorm:"primary_key;column:id;auto_increment:true"`
+	LinkID     uint      `gorm:"column:link_id"`
+	RemoteAddr string    `gorm:"column:remote_addr"`
+	UserAgent  string    `gorm:"column:user_agent"`
+	CreatedAt  time.Time `gorm:"column:created_at;autocreatetime"`
+}
+
+func (ShareLinkAccess) TableName() string { return "task_share_link_accesses" }
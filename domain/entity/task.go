@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/viettranx/service-context/core"
@@ -9,29 +10,251 @@ import (
 type Status string
 
 const (
+	// StatusTodo indicates task is not started yet.
+	StatusTodo Status = "todo"
 	// StatusDoing indicates task is in progress.
 	StatusDoing Status = "doing"
+	// StatusBlocked indicates task can not progress.
+	StatusBlocked Status = "blocked"
 	// StatusDone indicates task is completed.
 	StatusDone Status = "done"
+	// StatusCancelled indicates task is abandoned.
+	StatusCancelled Status = "cancelled"
 	// StatusDeleted indicates task is deleted.
 	StatusDeleted Status = "deleted"
+	// StatusArchived indicates task is archived, by itself or along with its
+	// project.
+	StatusArchived Status = "archived"
 )
 
+// StatusCategory groups statuses by how far the work of a task is. Every
+// status, built-in or defined by a workflow, belongs to one category.
+type StatusCategory string
+
+const (
+	// StatusCategoryOpen indicates work of task is not started.
+	StatusCategoryOpen StatusCategory = "open"
+	// StatusCategoryInProgress indicates work of task is ongoing.
+	StatusCategoryInProgress StatusCategory = "in_progress"
+	// StatusCategoryClosed indicates task needs no more work.
+	StatusCategoryClosed StatusCategory = "closed"
+)
+
+// statusCategories maps built-in statuses to their categories.
+var statusCategories = map[Status]StatusCategory{
+	StatusTodo:      StatusCategoryOpen,
+	StatusDoing:     StatusCategoryInProgress,
+	StatusBlocked:   StatusCategoryInProgress,
+	StatusDone:      StatusCategoryClosed,
+	StatusCancelled: StatusCategoryClosed,
+	StatusDeleted:   StatusCategoryClosed,
+	StatusArchived:  StatusCategoryClosed,
+}
+
+// statusTransitions defines allowed next statuses of each status. Deleted and
+// archived are reachable only by deleting or archiving, so they are not listed
+// here.
+var statusTransitions = map[Status][]Status{
+	StatusTodo:      {StatusDoing, StatusBlocked, StatusDone, StatusCancelled},
+	StatusDoing:     {StatusTodo, StatusBlocked, StatusDone, StatusCancelled},
+	StatusBlocked:   {StatusTodo, StatusDoing, StatusCancelled},
+	StatusDone:      {StatusDoing},
+	StatusCancelled: {StatusTodo},
+}
+
+// NextStatuses returns statuses which a task can move to from s.
+func (s Status) NextStatuses() []Status {
+	return statusTransitions[s]
+}
+
+// CanTransitionTo reports whether a task can move from s to next.
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, st := range statusTransitions[s] {
+		if st == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Category returns category of built-in status s.
+func (s Status) Category() StatusCategory {
+	return statusCategories[s]
+}
+
+// Priority indicates how urgent a task is. It is stored as an ordinal so tasks
+// can be sorted by priority, and encoded as its name in JSON.
+type Priority int
+
+const (
+	// PriorityNone indicates task has no priority.
+	PriorityNone Priority = iota
+	// PriorityLow indicates task has low priority.
+	PriorityLow
+	// PriorityMedium indicates task has medium priority.
+	PriorityMedium
+	// PriorityHigh indicates task has high priority.
+	PriorityHigh
+	// PriorityUrgent indicates task has urgent priority.
+	PriorityUrgent
+)
+
+var priorityNames = []string{"none", "low", "medium", "high", "urgent"}
+
+// ParsePriority returns the priority with the given name.
+func ParsePriority(name string) (Priority, error) {
+	for i, n := range priorityNames {
+		if n == name {
+			return Priority(i), nil
+		}
+	}
+
+	return PriorityNone, fmt.Errorf("invalid priority %q", name)
+}
+
+// String returns name of priority.
+func (p Priority) String() string {
+	if p < 0 || int(p) >= len(priorityNames) {
+		return priorityNames[PriorityNone]
+	}
+
+	return priorityNames[p]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Priority) UnmarshalText(text []byte) error {
+	v, err := ParsePriority(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = v
+	return nil
+}
+
 // Task defines data model for task.
 type Task struct {
-	ID          uint        `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
-	FakeID      *core.UID   `json:"id" gorm:"-"`
-	UserID      uint        `json:"user_id" gorm:"column:user_id"`
-	User        *SimpleUser `json:"user" gorm:"-"`
-	Title       string      `json:"title" gorm:"column:title"`
-	Description string      `json:"description" gorm:"column:description"`
-	Status      Status      `json:"status" gorm:"column:status"`
-	CreatedAt   time.Time   `json:"created_at" gorm:"column:created_at;autocreatetime"`
-	UpdatedAt   time.Time   `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+	ID               uint            `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID           *core.UID       `json:"id" gorm:"-"`
+	UserID           uint            `json:"user_id" gorm:"column:user_id"`
+	TenantID         string          `json:"-" gorm:"column:tenant_id"`
+	User             *SimpleUser     `json:"user" gorm:"-"`
+	ParentID         *uint           `json:"-" gorm:"column:parent_id"`
+	FakeParentID     *core.UID       `json:"parent_id" gorm:"-"`
+	Subtasks         *SubtaskStats   `json:"subtasks" gorm:"-"`
+	Checklist        *ChecklistStats `json:"checklist" gorm:"-"`
+	Title            string          `json:"title" gorm:"column:title"`
+	Description      string          `json:"description" gorm:"column:description"`
+	ProjectID        *uint           `json:"-" gorm:"column:project_id"`
+	FakeProjectID    *core.UID       `json:"project_id" gorm:"-"`
+	WorkflowID       *uint           `json:"-" gorm:"column:workflow_id"`
+	FakeWorkflowID   *core.UID       `json:"workflow_id" gorm:"-"`
+	Status           Status          `json:"status" gorm:"column:status"`
+	StatusCategory   StatusCategory  `json:"status_category" gorm:"column:status_category"`
+	PreviousStatus   *Status         `json:"previous_status,omitempty" gorm:"column:previous_status"`
+	Priority         Priority        `json:"priority" gorm:"column:priority"`
+	StartAt          *time.Time      `json:"start_at" gorm:"column:start_at"`
+	DueAt            *time.Time      `json:"due_at" gorm:"column:due_at"`
+	Recurrence       *string         `json:"recurrence" gorm:"column:recurrence"`
+	SeriesID         *uint           `json:"-" gorm:"column:series_id"`
+	FakeSeriesID     *core.UID       `json:"series_id,omitempty" gorm:"-"`
+	OccurrenceAt     *time.Time      `json:"-" gorm:"column:occurrence_at"`
+	OccurrenceNo     int             `json:"-" gorm:"column:occurrence_no"`
+	NextOccurrenceAt *time.Time      `json:"next_occurrence_at,omitempty" gorm:"column:next_occurrence_at"`
+	Overdue          bool            `json:"overdue" gorm:"-"`
+	Labels           []Label         `json:"labels" gorm:"-"`
+	AssigneeIDs      []uint          `json:"-" gorm:"-"`
+	Assignees        []SimpleUser    `json:"assignees" gorm:"-"`
+	DeletedAt        *time.Time      `json:"deleted_at,omitempty" gorm:"column:deleted_at"`
+	CreatedAt        time.Time       `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt        time.Time       `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
 }
 
 func (Task) TableName() string { return "tasks" }
 
+// SubtaskStats summarizes progress of subtasks of a task.
+type SubtaskStats struct {
+	Total int64 `json:"total"`
+	Done  int64 `json:"done"`
+}
+
+// IsOverdue reports whether task has passed its due date at the given time
+// without being completed.
+func (t *Task) IsOverdue(now time.Time) bool {
+	if t.DueAt == nil {
+		return false
+	}
+
+	if t.StatusCategory == StatusCategoryClosed {
+		return false
+	}
+
+	return now.After(*t.DueAt)
+}
+
+// RecurrenceAnchor returns the time which occurrences of a recurring task are
+// counted from: due date, else start date, else its current occurrence, else
+// creation time.
+func (t *Task) RecurrenceAnchor() time.Time {
+	if t.DueAt != nil {
+		return *t.DueAt
+	}
+
+	if t.StartAt != nil {
+		return *t.StartAt
+	}
+
+	if t.OccurrenceAt != nil {
+		return *t.OccurrenceAt
+	}
+
+	return t.CreatedAt
+}
+
+// ScheduleRecurrence sets occurrence of task to its anchor and computes when
+// the next occurrence is due, which is the first one after now. Occurrences
+// missed in between are skipped rather than created late, and do not count
+// toward COUNT. Task without recurrence has no occurrences.
+func (t *Task) ScheduleRecurrence(now time.Time) error {
+	if t.Recurrence == nil {
+		t.OccurrenceAt = nil
+		t.NextOccurrenceAt = nil
+		return nil
+	}
+
+	rule, err := ParseRecurrenceRule(*t.Recurrence)
+	if err != nil {
+		return err
+	}
+
+	if t.OccurrenceNo == 0 {
+		t.OccurrenceNo = 1
+	}
+
+	// Database keeps times to the second, compared times must match it
+	anchor := t.RecurrenceAnchor().Truncate(time.Second)
+	t.OccurrenceAt = &anchor
+	t.NextOccurrenceAt = nil
+
+	// Each step moves forward or ends the series, so catching up terminates
+	next, ok := rule.Next(anchor, t.OccurrenceNo)
+	for ok && !next.After(now) {
+		next, ok = rule.Next(next, t.OccurrenceNo)
+	}
+
+	if ok {
+		t.NextOccurrenceAt = &next
+	}
+
+	return nil
+}
+
 // SimpleUser only contains public infos.
 type SimpleUser struct {
 	ID        uint      `json:"-"`
@@ -43,6 +266,18 @@ type SimpleUser struct {
 const (
 	MaskTypeUser = iota + 1
 	MaskTypeTask
+	MaskTypeLabel
+	MaskTypeWorkflow
+	MaskTypeProject
+	MaskTypeShareLink
+	MaskTypeComment
+	MaskTypeActivity
+	MaskTypeChecklistItem
+	MaskTypeAttachment
+	MaskTypeReminder
+	MaskTypeNotification
+	MaskTypeWebhook
+	MaskTypeWebhookDelivery
 )
 
 func (u *SimpleUser) Mask() {
@@ -54,7 +289,47 @@ func (t *Task) Mask() {
 	uid := core.NewUID(uint32(t.ID), MaskTypeTask, 1)
 	t.FakeID = &uid
 
+	if t.ProjectID != nil {
+		projectUID := core.NewUID(uint32(*t.ProjectID), MaskTypeProject, 1)
+		t.FakeProjectID = &projectUID
+	}
+
+	if t.WorkflowID != nil {
+		workflowUID := core.NewUID(uint32(*t.WorkflowID), MaskTypeWorkflow, 1)
+		t.FakeWorkflowID = &workflowUID
+	}
+
+	if t.SeriesID != nil {
+		seriesUID := core.NewUID(uint32(*t.SeriesID), MaskTypeTask, 1)
+		t.FakeSeriesID = &seriesUID
+	}
+
+	if t.ParentID != nil {
+		parentUID := core.NewUID(uint32(*t.ParentID), MaskTypeTask, 1)
+		t.FakeParentID = &parentUID
+	}
+
 	if u := t.User; u != nil {
 		u.Mask()
 	}
+
+	for i := range t.Labels {
+		t.Labels[i].Mask()
+	}
+
+	for i := range t.Assignees {
+		t.Assignees[i].Mask()
+	}
+}
+
+// IsAssignee reports whether user is assigned to task. AssigneeIDs must be
+// loaded before.
+func (t *Task) IsAssignee(userID uint) bool {
+	for _, id := range t.AssigneeIDs {
+		if id == userID {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// TaskAssignee defines data model for association between task and an user
+// assigned to it.
+type TaskAssignee struct {
+	TaskID    uint      `gorm:"primary_key;column:task_id"`
+	UserID    uint      `gorm:"primary_key;column:user_id"`
+	CreatedAt time.Time `gorm:"column:created_at;autocreatetime"`
+}
+
+func (TaskAssignee) TableName() string { return "task_assignees" }
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// TaskDependency defines data model for dependency between tasks, where task
+// with BlockerID blocks task with TaskID.
+type TaskDependency struct {
+	TaskID    uint      `gorm:"primary_key;column:task_id"`
+	BlockerID uint      `gorm:"primary_key;column:blocker_id"`
+	CreatedAt time.Time `gorm:"column:created_at;autocreatetime"`
+}
+
+func (TaskDependency) TableName() string { return "task_dependencies" }
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+// SharePermission is the access granted to an user a task is shared with.
+type SharePermission string
+
+const (
+	// SharePermissionRead lets user see the task.
+	SharePermissionRead SharePermission = "read"
+	// SharePermissionEdit lets user see and change the task.
+	SharePermissionEdit SharePermission = "edit"
+)
+
+// Role returns the role granted on the task by permission.
+func (p SharePermission) Role() Role {
+	switch p {
+	case SharePermissionRead:
+		return RoleViewer
+	case SharePermissionEdit:
+		return RoleEditor
+	default:
+		return ""
+	}
+}
+
+// TaskShare defines data model for a task shared with an user, outside of
+// the roles they have on its project or tenant.
+type TaskShare struct {
+	TaskID     uint            `json:"-" gorm:"primary_key;column:task_id"`
+	UserID     uint            `json:"-" gorm:"primary_key;column:user_id"`
+	User       *SimpleUser     `json:"user" gorm:"-"`
+	Permission SharePermission `json:"permission" gorm:"column:permission"`
+	CreatedAt  time.Time       `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt  time.Time       `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (TaskShare) TableName() string { return "task_shares" }
+
+func (s *TaskShare) Mask() {
+	if u := s.User; u != nil {
+		u.Mask()
+	}
+}
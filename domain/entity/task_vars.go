@@ -1,7 +1,61 @@
 package entity
 
+import "time"
+
 // Filter condition to filter tasks.
 type Filter struct {
-	UserID *uint   `json:"user_id,omitempty"`
-	Status *string `json:"status,omitempty"`
+	UserID         *uint           `json:"user_id,omitempty"`
+	AssigneeID     *uint           `json:"assignee_id,omitempty"`
+	MentionedID    *uint           `json:"mentioned_id,omitempty"`
+	WatcherID      *uint           `json:"watcher_id,omitempty"`
+	ParentID       *uint           `json:"parent_id,omitempty"`
+	ProjectID      *uint           `json:"project_id,omitempty"`
+	WorkflowID     *uint           `json:"workflow_id,omitempty"`
+	Status         *string         `json:"status,omitempty"`
+	StatusCategory *StatusCategory `json:"status_category,omitempty"`
+	Priority       *Priority       `json:"priority,omitempty"`
+	DueBefore      *time.Time      `json:"due_before,omitempty"`
+	DueAfter       *time.Time      `json:"due_after,omitempty"`
+	Overdue        *bool           `json:"overdue,omitempty"`
+	Blocked        *bool           `json:"blocked,omitempty"`
+	Archived       *bool           `json:"archived,omitempty"`
+	LabelIDs       []uint          `json:"label_ids,omitempty"`
+	LabelMatch     LabelMatch      `json:"label_match,omitempty"`
+	// VisibleTo restricts tasks to the ones an user can see through ownership,
+	// assignment, sharing, or a role on their project.
+	VisibleTo *uint `json:"visible_to,omitempty"`
+	// Trashed lists deleted tasks instead of the ones not deleted.
+	Trashed bool `json:"trashed,omitempty"`
+}
+
+// SortField is a field which tasks can be sorted by.
+type SortField string
+
+const (
+	// SortFieldPriority sorts tasks by priority.
+	SortFieldPriority SortField = "priority"
+	// SortFieldDueAt sorts tasks by due date.
+	SortFieldDueAt SortField = "due_at"
+	// SortFieldCreatedAt sorts tasks by creation time.
+	SortFieldCreatedAt SortField = "created_at"
+	// SortFieldUpdatedAt sorts tasks by last update time.
+	SortFieldUpdatedAt SortField = "updated_at"
+	// SortFieldTitle sorts tasks by title.
+	SortFieldTitle SortField = "title"
+)
+
+// SortDirection is direction of sorting.
+type SortDirection string
+
+const (
+	// SortAsc sorts in ascending order.
+	SortAsc SortDirection = "asc"
+	// SortDesc sorts in descending order.
+	SortDesc SortDirection = "desc"
+)
+
+// Sort specifies how to order a list of tasks.
+type Sort struct {
+	Field     SortField     `json:"field"`
+	Direction SortDirection `json:"direction"`
 }
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// TaskWatcher defines data model for association between task and an user
+// following its changes.
+type TaskWatcher struct {
+	TaskID    uint      `gorm:"primary_key;column:task_id"`
+	UserID    uint      `gorm:"primary_key;column:user_id"`
+	CreatedAt time.Time `gorm:"column:created_at;autocreatetime"`
+}
+
+func (TaskWatcher) TableName() string { return "task_watchers" }
@@ -0,0 +1,188 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// WebhookEvent is a kind of change on tasks which webhooks can subscribe to.
+type WebhookEvent string
+
+const (
+	// WebhookEventTaskCreated is sent when a task is created.
+	WebhookEventTaskCreated WebhookEvent = "task.created"
+	// WebhookEventTaskUpdated is sent when fields of a task are updated.
+	WebhookEventTaskUpdated WebhookEvent = "task.updated"
+	// WebhookEventTaskDeleted is sent when a task is moved to trash.
+	WebhookEventTaskDeleted WebhookEvent = "task.deleted"
+	// WebhookEventTaskPurged is sent when a task is removed from trash for
+	// good.
+	WebhookEventTaskPurged WebhookEvent = "task.purged"
+)
+
+// WebhookEvents is a set of events stored as a comma separated list.
+type WebhookEvents []WebhookEvent
+
+// Scan implements sql.Scanner.
+func (e *WebhookEvents) Scan(value interface{}) error {
+	var text string
+
+	switch v := value.(type) {
+	case nil:
+	case []byte:
+		text = string(v)
+	case string:
+		text = v
+	default:
+		return fmt.Errorf("can not scan %T into webhook events", value)
+	}
+
+	events := make(WebhookEvents, 0)
+	for _, name := range strings.Split(text, ",") {
+		if name != "" {
+			events = append(events, WebhookEvent(name))
+		}
+	}
+	*e = events
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (e WebhookEvents) Value() (driver.Value, error) {
+	names := make([]string, len(e))
+	for i, event := range e {
+		names[i] = string(event)
+	}
+
+	return strings.Join(names, ","), nil
+}
+
+// WebhookStatus is the state of a webhook.
+type WebhookStatus string
+
+const (
+	// WebhookStatusActive webhook receives events.
+	WebhookStatusActive WebhookStatus = "active"
+	// WebhookStatusDisabled webhook receives nothing, it is disabled after
+	// too many failed deliveries in a row until it is enabled again.
+	WebhookStatusDisabled WebhookStatus = "disabled"
+)
+
+// Webhook defines data model for a subscription of an external endpoint to
+// changes on tasks of a tenant. Payloads posted to the endpoint are signed
+// with the secret of the webhook, so it is stored as is and never returned
+// after creation.
+type Webhook struct {
+	ID           uint          `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID       *core.UID     `json:"id" gorm:"-"`
+	TenantID     string        `json:"-" gorm:"column:tenant_id"`
+	UserID       uint          `json:"-" gorm:"column:user_id"`
+	URL          string        `json:"url" gorm:"column:url"`
+	Secret       string        `json:"-" gorm:"column:secret"`
+	Events       WebhookEvents `json:"events" gorm:"column:events"`
+	Status       WebhookStatus `json:"status" gorm:"column:status"`
+	FailureCount int           `json:"failure_count" gorm:"column:failure_count"`
+	DisabledAt   *time.Time    `json:"disabled_at" gorm:"column:disabled_at"`
+	CreatedAt    time.Time     `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt    time.Time     `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (Webhook) TableName() string { return "webhooks" }
+
+func (w *Webhook) Mask() {
+	uid := core.NewUID(uint32(w.ID), MaskTypeWebhook, 1)
+	w.FakeID = &uid
+}
+
+// Subscribes reports whether webhook receives event. Webhook without events
+// receives all of them.
+func (w *Webhook) Subscribes(event WebhookEvent) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SignWebhookPayload returns signature of a payload posted to a webhook, as
+// sent in its signature header: hex encoded HMAC-SHA256 of the payload keyed
+// by secret of the webhook, prefixed with "sha256=".
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDeliveryStatus is the state of a delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending delivery is waiting for its next attempt.
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSucceeded delivery was accepted by the endpoint.
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed delivery ran out of attempts.
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery defines data model for an event to post to a webhook. It is
+// attempted until the endpoint accepts it or attempts run out.
+type WebhookDelivery struct {
+	ID             uint                  `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID         *core.UID             `json:"id" gorm:"-"`
+	WebhookID      uint                  `json:"-" gorm:"column:webhook_id"`
+	Webhook        *Webhook              `json:"-" gorm:"-"`
+	Event          WebhookEvent          `json:"event" gorm:"column:event"`
+	Payload        string                `json:"payload" gorm:"column:payload"`
+	Status         WebhookDeliveryStatus `json:"status" gorm:"column:status"`
+	Attempts       int                   `json:"attempts" gorm:"column:attempts"`
+	ResponseStatus *int                  `json:"response_status" gorm:"column:response_status"`
+	LastError      *string               `json:"last_error" gorm:"column:last_error"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at" gorm:"column:next_attempt_at"`
+	LockedUntil    *time.Time            `json:"-" gorm:"column:locked_until"`
+	DeliveredAt    *time.Time            `json:"delivered_at" gorm:"column:delivered_at"`
+	CreatedAt      time.Time             `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt      time.Time             `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (WebhookDelivery) TableName() string { return "webhook_deliveries" }
+
+func (d *WebhookDelivery) Mask() {
+	uid := core.NewUID(uint32(d.ID), MaskTypeWebhookDelivery, 1)
+	d.FakeID = &uid
+}
+
+// WebhookAttempt defines data model for one attempt to post a delivery to its
+// webhook.
+type WebhookAttempt struct {
+	ID             uint      `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	DeliveryID     uint      `json:"-" gorm:"column:delivery_id"`
+	Attempt        int       `json:"attempt" gorm:"column:attempt"`
+	ResponseStatus *int      `json:"response_status" gorm:"column:response_status"`
+	ResponseBody   string    `json:"response_body" gorm:"column:response_body"`
+	Error          *string   `json:"error" gorm:"column:error"`
+	DurationMs     int64     `json:"duration_ms" gorm:"column:duration_ms"`
+	CreatedAt      time.Time `json:"created_at" gorm:"column:created_at;autocreatetime"`
+}
+
+func (WebhookAttempt) TableName() string { return "webhook_delivery_attempts" }
+
+// Succeeded reports whether endpoint accepted the delivery.
+func (a *WebhookAttempt) Succeeded() bool {
+	return a.Error == nil && a.ResponseStatus != nil && *a.ResponseStatus >= 200 && *a.ResponseStatus < 300
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/viettranx/service-context/core"
+)
+
+// Workflow defines data model for a custom set of statuses and the allowed
+// transitions between them. Workflow without project is shared by the whole
+// tenant of its creator, workflow of a project by members of that project.
+type Workflow struct {
+	ID            uint                 `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	FakeID        *core.UID            `json:"id" gorm:"-"`
+	TenantID      string               `json:"-" gorm:"column:tenant_id"`
+	UserID        uint                 `json:"-" gorm:"column:user_id"`
+	ProjectID     *uint                `json:"-" gorm:"column:project_id"`
+	FakeProjectID *core.UID            `json:"project_id" gorm:"-"`
+	Name          string               `json:"name" gorm:"column:name"`
+	Statuses      []WorkflowStatus     `json:"statuses" gorm:"-"`
+	Transitions   []WorkflowTransition `json:"transitions" gorm:"-"`
+	CreatedAt     time.Time            `json:"created_at" gorm:"column:created_at;autocreatetime"`
+	UpdatedAt     time.Time            `json:"updated_at" gorm:"column:updated_at;autoupdatetime"`
+}
+
+func (Workflow) TableName() string { return "workflows" }
+
+func (w *Workflow) Mask() {
+	uid := core.NewUID(uint32(w.ID), MaskTypeWorkflow, 1)
+	w.FakeID = &uid
+
+	if w.ProjectID != nil {
+		projectUID := core.NewUID(uint32(*w.ProjectID), MaskTypeProject, 1)
+		w.FakeProjectID = &projectUID
+	}
+}
+
+// InitialStatus returns the first status of workflow, which new tasks start
+// with.
+func (w *Workflow) InitialStatus() *WorkflowStatus {
+	if len(w.Statuses) == 0 {
+		return nil
+	}
+
+	return &w.Statuses[0]
+}
+
+// FindStatus returns status of workflow by its key.
+func (w *Workflow) FindStatus(key Status) *WorkflowStatus {
+	for i := range w.Statuses {
+		if w.Statuses[i].Key == key {
+			return &w.Statuses[i]
+		}
+	}
+
+	return nil
+}
+
+// NextStatuses returns statuses which a task can move to from status from.
+func (w *Workflow) NextStatuses(from Status) []Status {
+	next := make([]Status, 0)
+
+	for _, t := range w.Transitions {
+		if t.From == from {
+			next = append(next, t.To)
+		}
+	}
+
+	return next
+}
+
+// CanTransition reports whether a task can move from status from to status to.
+func (w *Workflow) CanTransition(from, to Status) bool {
+	for _, t := range w.Transitions {
+		if t.From == from && t.To == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WorkflowStatus defines data model for a status of workflow.
+type WorkflowStatus struct {
+	ID         uint           `json:"-" gorm:"primary_key;column:id;auto_increment:true"`
+	WorkflowID uint           `json:"-" gorm:"column:workflow_id"`
+	Key        Status         `json:"key" gorm:"column:status_key"`
+	Name       string         `json:"name" gorm:"column:name"`
+	Category   StatusCategory `json:"category" gorm:"column:category"`
+	Position   int            `json:"position" gorm:"column:position"`
+}
+
+func (WorkflowStatus) TableName() string { return "workflow_statuses" }
+
+// WorkflowTransition defines data model for an allowed transition between two
+// statuses of workflow.
+type WorkflowTransition struct {
+	WorkflowID uint   `json:"-" gorm:"primary_key;column:workflow_id"`
+	From       Status `json:"from" gorm:"primary_key;column:from_status"`
+	To         Status `json:"to" gorm:"primary_key;column:to_status"`
+}
+
+func (WorkflowTransition) TableName() string { return "workflow_transitions" }
@@ -0,0 +1,8 @@
+package entity
+
+// WorkflowFilter condition to filter workflows.
+type WorkflowFilter struct {
+	// ProjectID selects workflows of a project, nil selects workflows shared
+	// by the whole tenant.
+	ProjectID *uint `json:"project_id,omitempty"`
+}
@@ -1 +1,28 @@
 package domain
+
+import "github.com/quocdaitrn/cp-task/domain/entity"
+
+// StatusTransitionDetails describes a rejected status transition of a task,
+// including the statuses the task is allowed to move to. It is used as details
+// of kit errors returned to clients.
+type StatusTransitionDetails struct {
+	Message string          `json:"message"`
+	From    entity.Status   `json:"from"`
+	To      entity.Status   `json:"to"`
+	Allowed []entity.Status `json:"allowed"`
+}
+
+// NewStatusTransitionDetails creates and returns details of a rejected
+// transition from status from to status to.
+func NewStatusTransitionDetails(from, to entity.Status, allowed []entity.Status) StatusTransitionDetails {
+	if allowed == nil {
+		allowed = []entity.Status{}
+	}
+
+	return StatusTransitionDetails{
+		Message: "invalid status transition",
+		From:    from,
+		To:      to,
+		Allowed: allowed,
+	}
+}
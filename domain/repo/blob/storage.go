@@ -0,0 +1,26 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrObjectNotFound is returned when no object is stored under a key.
+var ErrObjectNotFound = errors.New("blob object not found")
+
+// Storage provides methods for storing contents of files. Objects are
+// addressed by keys made of path segments separated by slashes.
+type Storage interface {
+	// Put stores content read from r under key, replacing any object already
+	// stored there, and returns number of stored bytes.
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+
+	// Get opens the object stored under key for reading. Caller must close
+	// the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key. Deleting a missing object
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,23 @@
+package notifier
+
+import "context"
+
+// Message is a notification delivered to an user.
+type Message struct {
+	// Key identifies the message. Callers retry a message with the same key
+	// until it is acknowledged, so a message may be handed over more than
+	// once, and notifiers deliver at most one message per key.
+	Key      string
+	TenantID string
+	UserID   uint
+	TaskID   uint
+	Title    string
+	Body     string
+}
+
+// Notifier provides methods for delivering notifications to users.
+type Notifier interface {
+	// Notify delivers a message to its user. A message whose key was
+	// already delivered is acknowledged without being delivered again.
+	Notify(ctx context.Context, msg *Message) error
+}
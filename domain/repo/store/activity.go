@@ -0,0 +1,19 @@
+package store
+
+import (
+	"context"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ActivityRepo provides methods for interacting with activity data. Activities
+// are append only, so there is no way to update or delete them.
+type ActivityRepo interface {
+	// InsertMany appends activities to database.
+	InsertMany(ctx context.Context, activities []entity.Activity) error
+
+	// FindRangeByTaskID fetches list of activities of a task, newest first.
+	FindRangeByTaskID(ctx context.Context, taskID uint, paging *core.Paging) ([]entity.Activity, error)
+}
@@ -0,0 +1,22 @@
+package store
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// AttachmentRepo provides methods for interacting with attachment metadata.
+type AttachmentRepo interface {
+	// InsertOne inserts an attachment to database.
+	InsertOne(ctx context.Context, attachment *entity.Attachment) error
+
+	// DeleteOne deletes an attachment from database.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches an attachment from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Attachment, error)
+
+	// FindByTaskIDs fetches all attachments of list of tasks, oldest first.
+	FindByTaskIDs(ctx context.Context, taskIDs []uint) ([]entity.Attachment, error)
+}
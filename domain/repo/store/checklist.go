@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ChecklistItemRepo provides methods for interacting with checklist item data.
+type ChecklistItemRepo interface {
+	// InsertOne inserts an item at the end of the checklist of its task.
+	InsertOne(ctx context.Context, item *entity.ChecklistItem) error
+
+	// UpdateOne updates an item to database.
+	UpdateOne(ctx context.Context, item *entity.ChecklistItem) error
+
+	// DeleteOne deletes an item from database.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches an item from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.ChecklistItem, error)
+
+	// FindByTaskID fetches all items of the checklist of a task, ordered by
+	// position.
+	FindByTaskID(ctx context.Context, taskID uint) ([]entity.ChecklistItem, error)
+
+	// Reorder sets positions of items of a task following the order of ids.
+	Reorder(ctx context.Context, taskID uint, ids []uint) error
+
+	// CountByTaskIDs counts total and checked items of list of tasks, grouped
+	// by task id.
+	CountByTaskIDs(ctx context.Context, taskIDs []uint) (map[uint]entity.ChecklistStats, error)
+}
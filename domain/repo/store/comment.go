@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// CommentRepo provides methods for interacting with comment data.
+type CommentRepo interface {
+	// InsertOne inserts a comment to database.
+	InsertOne(ctx context.Context, comment *entity.Comment) error
+
+	// UpdateOne updates a comment to database.
+	UpdateOne(ctx context.Context, comment *entity.Comment) error
+
+	// DeleteOne deletes a comment along with its replies and their mentions
+	// from database.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a comment from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Comment, error)
+
+	// FindRangeByTaskID fetches list of root comments of a task, oldest first.
+	FindRangeByTaskID(ctx context.Context, taskID uint, paging *core.Paging) ([]entity.Comment, error)
+
+	// FindReplies fetches replies of list of comments, grouped by parent
+	// comment id.
+	FindReplies(ctx context.Context, parentIDs []uint) (map[uint][]entity.Comment, error)
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// LabelRepo provides methods for interacting with label data.
+type LabelRepo interface {
+	// InsertOne inserts a label to database.
+	InsertOne(ctx context.Context, label *entity.Label) error
+
+	// UpdateOne updates a label to database.
+	UpdateOne(ctx context.Context, label *entity.Label) error
+
+	// DeleteOne deletes a label and all its task associations from database.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a label from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Label, error)
+
+	// FindByIDs fetches list of labels by list of ids.
+	FindByIDs(ctx context.Context, ids []uint) ([]entity.Label, error)
+
+	// FindRangeByCriteria fetches list of labels by criteria.
+	FindRangeByCriteria(ctx context.Context, filter *entity.LabelFilter, paging *core.Paging) ([]entity.Label, error)
+
+	// AttachToTask attaches labels to a task. Labels already attached are
+	// ignored.
+	AttachToTask(ctx context.Context, taskID uint, labelIDs []uint) error
+
+	// DetachFromTask detaches a label from a task.
+	DetachFromTask(ctx context.Context, taskID uint, labelID uint) error
+
+	// FindByTaskIDs fetches labels of list of tasks, grouped by task id.
+	FindByTaskIDs(ctx context.Context, taskIDs []uint) (map[uint][]entity.Label, error)
+}
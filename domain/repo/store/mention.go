@@ -0,0 +1,14 @@
+package store
+
+import (
+	"context"
+)
+
+// MentionRepo provides methods for interacting with mention data.
+type MentionRepo interface {
+	// ReplaceForTask replaces users mentioned in description of a task.
+	ReplaceForTask(ctx context.Context, taskID uint, userIDs []uint) error
+
+	// ReplaceForComment replaces users mentioned in a comment of a task.
+	ReplaceForComment(ctx context.Context, taskID uint, commentID uint, userIDs []uint) error
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// NotificationRepo provides methods for interacting with notification data.
+// Reads and changes are scoped to the tenant of requester carried by ctx.
+type NotificationRepo interface {
+	// InsertMany inserts list of notifications to database. Notifications
+	// keep the tenant they were given. A notification of a reminder already
+	// recorded for the same user is left out.
+	InsertMany(ctx context.Context, notifications []entity.Notification) error
+
+	// FindRangeByCriteria fetches list of notifications by criteria, newest
+	// first.
+	FindRangeByCriteria(ctx context.Context, filter *entity.NotificationFilter, paging *core.Paging) ([]entity.Notification, error)
+
+	// CountUnread counts notifications of an user which are not read yet.
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+
+	// MarkRead marks a notification of an user as read. It reports false if
+	// user has no such notification.
+	MarkRead(ctx context.Context, userID uint, id uint, readAt time.Time) (bool, error)
+
+	// MarkAllRead marks all notifications of an user as read.
+	MarkAllRead(ctx context.Context, userID uint, readAt time.Time) error
+}
@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ProjectRepo provides methods for interacting with project data. All methods
+// are scoped to the tenant of requester carried by ctx, so projects of other
+// tenants are never seen nor modified.
+type ProjectRepo interface {
+	// InsertOne inserts a project to database.
+	InsertOne(ctx context.Context, project *entity.Project) error
+
+	// UpdateOne updates a project to database.
+	UpdateOne(ctx context.Context, project *entity.Project) error
+
+	// DeleteOne soft deletes a project and all its tasks.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// ArchiveOne archives a project and all its tasks which are not deleted.
+	ArchiveOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a project from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Project, error)
+
+	// FindRangeByCriteria fetches list of projects by criteria.
+	FindRangeByCriteria(ctx context.Context, filter *entity.ProjectFilter, paging *core.Paging) ([]entity.Project, error)
+}
@@ -0,0 +1,37 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ReminderRepo provides methods for interacting with reminder data.
+type ReminderRepo interface {
+	// InsertOne inserts a reminder to database.
+	InsertOne(ctx context.Context, reminder *entity.Reminder) error
+
+	// DeleteOne deletes a reminder from database.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a reminder from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Reminder, error)
+
+	// FindByTaskID fetches reminders of an user on a task, soonest first.
+	FindByTaskID(ctx context.Context, taskID uint, userID uint) ([]entity.Reminder, error)
+
+	// RescheduleForTask moves reminders of a task which are relative to its
+	// due date and not fired yet, following the new due date.
+	RescheduleForTask(ctx context.Context, taskID uint, dueAt *time.Time) error
+
+	// ClaimDue fetches reminders of all tenants which are due at the given
+	// time and not fired yet, at most limit of them, along with their tasks.
+	// Claimed reminders are locked for lease, so they are not claimed again
+	// meanwhile unless they are not marked as fired before it ends. Reminders
+	// of deleted, archived and closed tasks are not claimed.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Reminder, error)
+
+	// MarkFired marks a claimed reminder as fired, so it never fires again.
+	MarkFired(ctx context.Context, id uint, firedAt time.Time) error
+}
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// RoleBindingRepo provides methods for interacting with role binding data.
+// All methods are scoped to the tenant of requester carried by ctx.
+type RoleBindingRepo interface {
+	// UpsertOne grants a role to an user on a project, replacing the role
+	// granted before if any.
+	UpsertOne(ctx context.Context, binding *entity.RoleBinding) error
+
+	// DeleteOne revokes role of an user on a project.
+	DeleteOne(ctx context.Context, projectID uint, userID uint) error
+
+	// DeleteOneOnTenant revokes role of an user on whole tenant.
+	DeleteOneOnTenant(ctx context.Context, userID uint) error
+
+	// FindRoles fetches roles of an user on whole tenant and, if projectID is
+	// given, on that project.
+	FindRoles(ctx context.Context, userID uint, projectID *uint) ([]entity.Role, error)
+
+	// FindRangeByProject fetches list of role bindings on a project.
+	FindRangeByProject(ctx context.Context, projectID uint, paging *core.Paging) ([]entity.RoleBinding, error)
+
+	// FindRangeOnTenant fetches list of role bindings on whole tenant.
+	FindRangeOnTenant(ctx context.Context, paging *core.Paging) ([]entity.RoleBinding, error)
+}
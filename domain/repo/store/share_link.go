@@ -0,0 +1,28 @@
+package store
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ShareLinkRepo provides methods for interacting with share link data.
+type ShareLinkRepo interface {
+	// InsertOne inserts a share link to database.
+	InsertOne(ctx context.Context, link *entity.ShareLink) error
+
+	// RevokeOne revokes a share link so it can not be used anymore.
+	RevokeOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a share link from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.ShareLink, error)
+
+	// FindByTokenHash fetches a share link from database by hash of its token.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.ShareLink, error)
+
+	// FindByTaskID fetches all share links of a task, revoked ones included.
+	FindByTaskID(ctx context.Context, taskID uint) ([]entity.ShareLink, error)
+
+	// InsertAccess records an access through a share link.
+	InsertAccess(ctx context.Context, access *entity.ShareLinkAccess) error
+}
@@ -2,13 +2,18 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/viettranx/service-context/core"
 
 	"github.com/quocdaitrn/cp-task/domain/entity"
 )
 
-// TaskRepo provides methods for interacting with task data.
+// TaskRepo provides methods for interacting with task data. All methods are
+// scoped to the tenant of requester carried by ctx, so tasks of other tenants
+// are never seen nor modified, except FindDeletedBefore, PurgeMany,
+// FindDueRecurring and InsertNextOccurrence which are run by background jobs
+// for all tenants.
 type TaskRepo interface {
 	// InsertOne inserts a task to database.
 	InsertOne(ctx context.Context, task *entity.Task) error
@@ -16,12 +21,51 @@ type TaskRepo interface {
 	// UpdateOne updates a task to database.
 	UpdateOne(ctx context.Context, task *entity.Task) error
 
-	// DeleteOne deletes a task from database.
+	// DeleteOne deletes a task from database. Task is only moved to trash, so
+	// it can be restored to its previous status later.
 	DeleteOne(ctx context.Context, id uint) error
 
+	// ArchiveOne archives a task. Current status of task is kept, so it can
+	// be unarchived later.
+	ArchiveOne(ctx context.Context, id uint) error
+
+	// Purge permanently deletes a task and all its related data from
+	// database. Subtasks of the task are moved to top level.
+	Purge(ctx context.Context, id uint) error
+
+	// FindDeletedBefore fetches ids of tasks of all tenants which were moved
+	// to trash before the given time, at most limit of them.
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]uint, error)
+
+	// PurgeMany permanently deletes tasks of all tenants with given ids and
+	// all their related data from database.
+	PurgeMany(ctx context.Context, ids []uint) error
+
+	// FindDueRecurring fetches recurring tasks of all tenants whose next
+	// occurrence is due before the given time and not created yet, at most
+	// limit of them. Deleted and archived tasks do not recur.
+	FindDueRecurring(ctx context.Context, before time.Time, limit int) ([]entity.Task, error)
+
+	// InsertNextOccurrence inserts next occurrence of a recurring task, along
+	// with labels, assignees, watchers, mentions and reminders relative to due
+	// date of the task. Only one next
+	// occurrence is ever inserted for a task: it reports false, inserting
+	// nothing, when next occurrence of prev was already created.
+	InsertNextOccurrence(ctx context.Context, prev *entity.Task, next *entity.Task) (bool, error)
+
 	// FindOne fetches a task from database by id.
 	FindOne(ctx context.Context, id uint) (*entity.Task, error)
 
-	// FindRangeByCriteria fetches list of tasks by criteria.
-	FindRangeByCriteria(ctx context.Context, filter *entity.Filter, paging *core.Paging) ([]entity.Task, error)
+	// FindRangeByCriteria fetches list of tasks by criteria. Tasks are ordered
+	// by sort if it is given, otherwise by newest first.
+	FindRangeByCriteria(ctx context.Context, filter *entity.Filter, sort *entity.Sort, paging *core.Paging) ([]entity.Task, error)
+
+	// CountSubtasks counts total and done subtasks of list of tasks, grouped by
+	// parent task id.
+	CountSubtasks(ctx context.Context, parentIDs []uint) (map[uint]entity.SubtaskStats, error)
+
+	// CountByWorkflow counts tasks using workflow, or a specific status of it
+	// if status is given. Deleted and archived tasks count too, by the status
+	// they will be restored to.
+	CountByWorkflow(ctx context.Context, workflowID uint, status *entity.Status) (int64, error)
 }
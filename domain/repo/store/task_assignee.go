@@ -0,0 +1,19 @@
+package store
+
+import (
+	"context"
+)
+
+// TaskAssigneeRepo provides methods for interacting with task's assignees
+// data.
+type TaskAssigneeRepo interface {
+	// InsertMany assigns users to a task. Users already assigned are ignored.
+	InsertMany(ctx context.Context, taskID uint, userIDs []uint) error
+
+	// DeleteOne unassigns an user from a task.
+	DeleteOne(ctx context.Context, taskID uint, userID uint) error
+
+	// FindUserIDsByTaskIDs fetches ids of users assigned to list of tasks,
+	// grouped by task id.
+	FindUserIDsByTaskIDs(ctx context.Context, taskIDs []uint) (map[uint][]uint, error)
+}
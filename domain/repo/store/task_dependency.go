@@ -0,0 +1,26 @@
+package store
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// TaskDependencyRepo provides methods for interacting with dependencies
+// between tasks.
+type TaskDependencyRepo interface {
+	// InsertOne inserts a dependency to database. Existing dependency is
+	// ignored.
+	InsertOne(ctx context.Context, dependency *entity.TaskDependency) error
+
+	// DeleteOne deletes a dependency from database.
+	DeleteOne(ctx context.Context, taskID uint, blockerID uint) error
+
+	// FindBlockerIDsByTaskIDs fetches ids of tasks blocking list of tasks,
+	// grouped by blocked task id.
+	FindBlockerIDsByTaskIDs(ctx context.Context, taskIDs []uint) (map[uint][]uint, error)
+
+	// FindUnfinishedBlockerIDs fetches ids of tasks blocking a task which are
+	// not closed yet.
+	FindUnfinishedBlockerIDs(ctx context.Context, taskID uint) ([]uint, error)
+}
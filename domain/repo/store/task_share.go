@@ -0,0 +1,23 @@
+package store
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// TaskShareRepo provides methods for interacting with task's shares data.
+type TaskShareRepo interface {
+	// UpsertOne shares a task with an user, replacing the permission granted
+	// before if any.
+	UpsertOne(ctx context.Context, share *entity.TaskShare) error
+
+	// DeleteOne stops sharing a task with an user.
+	DeleteOne(ctx context.Context, taskID uint, userID uint) error
+
+	// FindOne fetches share of a task with an user.
+	FindOne(ctx context.Context, taskID uint, userID uint) (*entity.TaskShare, error)
+
+	// FindByTaskID fetches all shares of a task.
+	FindByTaskID(ctx context.Context, taskID uint) ([]entity.TaskShare, error)
+}
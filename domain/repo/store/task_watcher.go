@@ -0,0 +1,18 @@
+package store
+
+import (
+	"context"
+)
+
+// TaskWatcherRepo provides methods for interacting with task's watchers data.
+type TaskWatcherRepo interface {
+	// InsertOne makes an user watch a task. User already watching is ignored.
+	InsertOne(ctx context.Context, taskID uint, userID uint) error
+
+	// DeleteOne makes an user stop watching a task.
+	DeleteOne(ctx context.Context, taskID uint, userID uint) error
+
+	// FindUserIDsByTaskID fetches ids of users watching a task, in order they
+	// started watching.
+	FindUserIDsByTaskID(ctx context.Context, taskID uint) ([]uint, error)
+}
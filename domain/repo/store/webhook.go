@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// WebhookRepo provides methods for interacting with webhook data, along with
+// their deliveries. Reads and changes of webhooks are scoped to the tenant of
+// requester carried by ctx.
+type WebhookRepo interface {
+	// InsertOne inserts a webhook to database.
+	InsertOne(ctx context.Context, webhook *entity.Webhook) error
+
+	// UpdateOne updates a webhook in database.
+	UpdateOne(ctx context.Context, webhook *entity.Webhook) error
+
+	// DeleteOne deletes a webhook from database, along with its deliveries.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a webhook from database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Webhook, error)
+
+	// FindRange fetches list of webhooks, newest first.
+	FindRange(ctx context.Context, paging *core.Paging) ([]entity.Webhook, error)
+
+	// FindActive fetches all active webhooks.
+	FindActive(ctx context.Context) ([]entity.Webhook, error)
+
+	// RecordSuccess resets count of failed attempts of a webhook in a row.
+	RecordSuccess(ctx context.Context, id uint) error
+
+	// RecordFailure counts a failed attempt of a webhook, and disables the
+	// webhook once failures in a row reach disableAfter. It reports whether
+	// the webhook was disabled by this failure.
+	RecordFailure(ctx context.Context, id uint, disableAfter int, now time.Time) (bool, error)
+
+	// InsertDeliveries inserts list of deliveries to database.
+	InsertDeliveries(ctx context.Context, deliveries []entity.WebhookDelivery) error
+
+	// FindDelivery fetches a delivery from database by id.
+	FindDelivery(ctx context.Context, id uint) (*entity.WebhookDelivery, error)
+
+	// FindDeliveries fetches list of deliveries of a webhook, newest first.
+	FindDeliveries(ctx context.Context, webhookID uint, paging *core.Paging) ([]entity.WebhookDelivery, error)
+
+	// FindAttempts fetches all attempts of a delivery, in order.
+	FindAttempts(ctx context.Context, deliveryID uint) ([]entity.WebhookAttempt, error)
+
+	// ClaimDueDeliveries fetches pending deliveries of all tenants whose next
+	// attempt is due at the given time, at most limit of them, along with
+	// their webhooks. Claimed deliveries are locked for lease, so they are
+	// not claimed again meanwhile unless their attempt is not recorded before
+	// it ends. Deliveries of disabled webhooks are not claimed.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.WebhookDelivery, error)
+
+	// RecordAttempt inserts an attempt of a claimed delivery, and saves the
+	// outcome of the attempt on the delivery, releasing it.
+	RecordAttempt(ctx context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error
+}
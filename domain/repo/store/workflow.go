@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// WorkflowRepo provides methods for interacting with workflow data. Reads and
+// changes are scoped to the tenant of requester carried by ctx.
+type WorkflowRepo interface {
+	// InsertOne inserts a workflow with its statuses and transitions to
+	// database.
+	InsertOne(ctx context.Context, workflow *entity.Workflow) error
+
+	// UpdateOne updates a workflow to database, replacing its statuses and
+	// transitions.
+	UpdateOne(ctx context.Context, workflow *entity.Workflow) error
+
+	// DeleteOne deletes a workflow with its statuses and transitions from
+	// database.
+	DeleteOne(ctx context.Context, id uint) error
+
+	// FindOne fetches a workflow with its statuses and transitions from
+	// database by id.
+	FindOne(ctx context.Context, id uint) (*entity.Workflow, error)
+
+	// FindRangeByCriteria fetches list of workflows with their statuses and
+	// transitions by criteria.
+	FindRangeByCriteria(ctx context.Context, filter *entity.WorkflowFilter, paging *core.Paging) ([]entity.Workflow, error)
+}
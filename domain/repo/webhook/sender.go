@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+)
+
+// Request is a payload posted to the endpoint of a webhook.
+type Request struct {
+	URL     string
+	Headers http.Header
+	Body    []byte
+}
+
+// Response is what the endpoint of a webhook answered.
+type Response struct {
+	StatusCode int
+	// Body is the beginning of the response body, kept for troubleshooting.
+	Body string
+}
+
+// Sender provides methods for posting payloads to endpoints of webhooks.
+type Sender interface {
+	// Send posts a request to its endpoint. An error is returned only when no
+	// response was received; any response, whatever its status, is returned
+	// as is.
+	Send(ctx context.Context, req *Request) (*Response, error)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// AttachmentService exposes all available use cases of attachment domain.
+type AttachmentService interface {
+	// UploadAttachment attaches a new file to a specific task.
+	UploadAttachment(ctx context.Context, req *UploadAttachmentRequest) (*UploadAttachmentResponse, error)
+
+	// ListAttachments finds and returns all attachments of a specific task.
+	ListAttachments(ctx context.Context, req *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+
+	// DownloadAttachment opens content of a specific attachment.
+	DownloadAttachment(ctx context.Context, req *DownloadAttachmentRequest) (*DownloadAttachmentResponse, error)
+
+	// DeleteAttachment deletes a specific attachment along with its file.
+	DeleteAttachment(ctx context.Context, req *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+}
+
+// UploadAttachmentRequest represent a request to attach a file to a task.
+// File is streamed from the request body, so it must be read before the
+// request ends.
+type UploadAttachmentRequest struct {
+	TaskID   string    `validate:"required"`
+	FileName string    `validate:"required,max=255"`
+	File     io.Reader `validate:"required"`
+}
+
+// UploadAttachmentResponse represent a response for attaching a file to a
+// task.
+type UploadAttachmentResponse struct {
+	*entity.Attachment
+}
+
+// ListAttachmentsRequest represent a request to get attachments of a task.
+type ListAttachmentsRequest struct {
+	TaskID string `param:"id" validate:"required"`
+}
+
+// ListAttachmentsResponse represent a response for listing attachments of a
+// task.
+type ListAttachmentsResponse struct {
+	Items []entity.Attachment `json:"items"`
+}
+
+// DownloadAttachmentRequest represent a request to download an attachment.
+type DownloadAttachmentRequest struct {
+	TaskID       string `param:"id" validate:"required"`
+	AttachmentID string `param:"attachment_id" validate:"required"`
+}
+
+// DownloadAttachmentResponse represent a response for downloading an
+// attachment. Caller must close Content.
+type DownloadAttachmentResponse struct {
+	*entity.Attachment
+	Content io.ReadCloser `json:"-"`
+}
+
+// DeleteAttachmentRequest represent a request to delete an attachment.
+type DeleteAttachmentRequest struct {
+	TaskID       string `param:"id" validate:"required"`
+	AttachmentID string `param:"attachment_id" validate:"required"`
+}
+
+// DeleteAttachmentResponse represent a response for deleting an attachment.
+type DeleteAttachmentResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// Authorizer decides whether requester is allowed to do actions on tasks and
+// projects. It is the single place where access rules are evaluated, so use
+// cases stay the same whichever rules are plugged in.
+type Authorizer interface {
+	// AuthorizeTask fails with kiterrors.ErrForbidden if requester is not
+	// allowed to do action on task.
+	AuthorizeTask(ctx context.Context, task *entity.Task, action entity.Action) error
+
+	// FilterTaskViewers returns ids among userIDs of users allowed to view
+	// task, in the same order.
+	FilterTaskViewers(ctx context.Context, task *entity.Task, userIDs []uint) ([]uint, error)
+
+	// AuthorizeProject fails with kiterrors.ErrForbidden if requester is not
+	// allowed to do action on project.
+	AuthorizeProject(ctx context.Context, project *entity.Project, action entity.Action) error
+
+	// AuthorizeWorkflow fails with kiterrors.ErrForbidden if requester is not
+	// allowed to do action on workflow. Using a workflow for tasks or projects
+	// needs view.
+	AuthorizeWorkflow(ctx context.Context, workflow *entity.Workflow, action entity.Action) error
+
+	// AuthorizeTenant fails with kiterrors.ErrForbidden if requester is not
+	// allowed to do action on whole tenant, such as managing its webhooks.
+	AuthorizeTenant(ctx context.Context, action entity.Action) error
+
+	// ScopeTasks narrows filter to tasks requester is allowed to view.
+	ScopeTasks(ctx context.Context, filter *entity.Filter) error
+}
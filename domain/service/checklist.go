@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ChecklistService exposes all available use cases of checklist domain.
+type ChecklistService interface {
+	// AddChecklistItem adds a new item at the end of the checklist of a
+	// specific task.
+	AddChecklistItem(ctx context.Context, req *AddChecklistItemRequest) (*AddChecklistItemResponse, error)
+
+	// ListChecklistItems finds and returns all items of the checklist of a
+	// specific task.
+	ListChecklistItems(ctx context.Context, req *ListChecklistItemsRequest) (*ListChecklistItemsResponse, error)
+
+	// UpdateChecklistItem updates text of a specific item, or checks and
+	// unchecks it.
+	UpdateChecklistItem(ctx context.Context, req *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error)
+
+	// ReorderChecklistItems changes order of all items of the checklist of a
+	// specific task.
+	ReorderChecklistItems(ctx context.Context, req *ReorderChecklistItemsRequest) (*ReorderChecklistItemsResponse, error)
+
+	// DeleteChecklistItem deletes a specific item.
+	DeleteChecklistItem(ctx context.Context, req *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
+}
+
+// AddChecklistItemRequest represent a request to add an item to a checklist.
+type AddChecklistItemRequest struct {
+	TaskID string `json:"-" param:"id" validate:"required"`
+	Text   string `json:"text" validate:"required,max=512"`
+}
+
+// AddChecklistItemResponse represent a response for adding an item to a
+// checklist.
+type AddChecklistItemResponse struct {
+	Message string `json:"message"`
+}
+
+// ListChecklistItemsRequest represent a request to get items of the
+// checklist of a task.
+type ListChecklistItemsRequest struct {
+	TaskID string `param:"id" validate:"required"`
+}
+
+// ListChecklistItemsResponse represent a response for listing items of the
+// checklist of a task.
+type ListChecklistItemsResponse struct {
+	Items []entity.ChecklistItem `json:"items"`
+}
+
+// UpdateChecklistItemRequest represent a request to update a checklist item.
+type UpdateChecklistItemRequest struct {
+	TaskID  string  `json:"-" param:"id" validate:"required"`
+	ItemID  string  `json:"-" param:"item_id" validate:"required"`
+	Text    *string `json:"text" validate:"omitempty,max=512"`
+	Checked *bool   `json:"checked"`
+}
+
+// UpdateChecklistItemResponse represent a response for updating a checklist
+// item.
+type UpdateChecklistItemResponse struct {
+	Message string `json:"message"`
+}
+
+// ReorderChecklistItemsRequest represent a request to change order of items
+// of a checklist.
+type ReorderChecklistItemsRequest struct {
+	TaskID  string   `json:"-" param:"id" validate:"required"`
+	ItemIDs []string `json:"item_ids" validate:"required,min=1"`
+}
+
+// ReorderChecklistItemsResponse represent a response for changing order of
+// items of a checklist.
+type ReorderChecklistItemsResponse struct {
+	Message string `json:"message"`
+}
+
+// DeleteChecklistItemRequest represent a request to delete a checklist item.
+type DeleteChecklistItemRequest struct {
+	TaskID string `param:"id" validate:"required"`
+	ItemID string `param:"item_id" validate:"required"`
+}
+
+// DeleteChecklistItemResponse represent a response for deleting a checklist
+// item.
+type DeleteChecklistItemResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// CommentService exposes all available use cases of comment domain.
+type CommentService interface {
+	// CreateComment creates a new comment on a specific task, or a reply to
+	// another comment.
+	CreateComment(ctx context.Context, req *CreateCommentRequest) (*CreateCommentResponse, error)
+
+	// ListComments finds and returns a list of comments of a specific task,
+	// with their replies.
+	ListComments(ctx context.Context, req *ListCommentsRequest) (*ListCommentsResponse, error)
+
+	// UpdateComment updates a specific comment.
+	UpdateComment(ctx context.Context, req *UpdateCommentRequest) (*UpdateCommentResponse, error)
+
+	// DeleteComment deletes a specific comment along with its replies.
+	DeleteComment(ctx context.Context, req *DeleteCommentRequest) (*DeleteCommentResponse, error)
+}
+
+// CreateCommentRequest represent a request to create a comment.
+type CreateCommentRequest struct {
+	TaskID   string  `json:"-" param:"id" validate:"required"`
+	Body     string  `json:"body" validate:"required,max=10000"`
+	ParentID *string `json:"parent_id"`
+}
+
+// CreateCommentResponse represent a response for creating a comment.
+type CreateCommentResponse struct {
+	Message string `json:"message"`
+}
+
+// ListCommentsRequest represent a request to get a list of comments of a
+// task.
+type ListCommentsRequest struct {
+	TaskID string `json:"-" param:"id" validate:"required"`
+	Page   int    `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit  int    `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListCommentsResponse represent a response for listing comments of a task.
+type ListCommentsResponse struct {
+	Items   []entity.Comment `json:"items"`
+	HasNext bool             `json:"has_next"`
+	Page    uint             `json:"page"`
+	Limit   uint             `json:"limit"`
+}
+
+// UpdateCommentRequest represent a request to update a comment.
+type UpdateCommentRequest struct {
+	TaskID    string `json:"-" param:"id" validate:"required"`
+	CommentID string `json:"-" param:"comment_id" validate:"required"`
+	Body      string `json:"body" validate:"required,max=10000"`
+}
+
+// UpdateCommentResponse represent a response for updating a comment.
+type UpdateCommentResponse struct {
+	Message string `json:"message"`
+}
+
+// DeleteCommentRequest represent a request to delete a comment.
+type DeleteCommentRequest struct {
+	TaskID    string `param:"id" validate:"required"`
+	CommentID string `param:"comment_id" validate:"required"`
+}
+
+// DeleteCommentResponse represent a response for deleting a comment.
+type DeleteCommentResponse struct {
+	Message string `json:"message"`
+}
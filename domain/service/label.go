@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// LabelService exposes all available use cases of label domain.
+type LabelService interface {
+	// CreateLabel creates a new label.
+	CreateLabel(ctx context.Context, req *CreateLabelRequest) (*CreateLabelResponse, error)
+
+	// GetLabel finds and returns a specific label.
+	GetLabel(ctx context.Context, req *GetLabelRequest) (*GetLabelResponse, error)
+
+	// ListLabels finds and returns a list of labels of requester.
+	ListLabels(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error)
+
+	// UpdateLabel updates a specific label.
+	UpdateLabel(ctx context.Context, req *UpdateLabelRequest) (*UpdateLabelResponse, error)
+
+	// DeleteLabel deletes a specific label.
+	DeleteLabel(ctx context.Context, req *DeleteLabelRequest) (*DeleteLabelResponse, error)
+}
+
+// CreateLabelRequest represent a request to create a label.
+type CreateLabelRequest struct {
+	Name  string `json:"name" validate:"required,max=64"`
+	Color string `json:"color" validate:"omitempty,hexcolor"`
+}
+
+// CreateLabelResponse represent a response for creating a label.
+type CreateLabelResponse struct {
+	Message string `json:"message"`
+}
+
+// GetLabelRequest represent a request to get a label.
+type GetLabelRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// GetLabelResponse represent a response for getting a label.
+type GetLabelResponse struct {
+	*entity.Label
+}
+
+// ListLabelsRequest represent a request to get a list of labels.
+type ListLabelsRequest struct {
+	Page  int `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListLabelsResponse represent a response for listing labels.
+type ListLabelsResponse struct {
+	Items   []entity.Label `json:"items"`
+	HasNext bool           `json:"has_next"`
+	Page    uint           `json:"page"`
+	Limit   uint           `json:"limit"`
+}
+
+// UpdateLabelRequest represent a request to update a label.
+type UpdateLabelRequest struct {
+	ID    string  `json:"-"  param:"id" validate:"required"`
+	Name  *string `json:"name" validate:"omitempty,max=64"`
+	Color *string `json:"color" validate:"omitempty,hexcolor"`
+}
+
+// UpdateLabelResponse represent a response for updating a label.
+type UpdateLabelResponse struct {
+	Message string `json:"message"`
+}
+
+// DeleteLabelRequest represent a request to delete a label.
+type DeleteLabelRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// DeleteLabelResponse represent a response for deleting a label.
+type DeleteLabelResponse struct {
+	Message string `json:"message"`
+}
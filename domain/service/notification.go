@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// NotificationService exposes all available use cases of notification domain.
+type NotificationService interface {
+	// ListNotifications finds and returns notifications of requester, newest
+	// first.
+	ListNotifications(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error)
+
+	// CountUnreadNotifications counts notifications of requester which are
+	// not read yet.
+	CountUnreadNotifications(ctx context.Context, req *CountUnreadNotificationsRequest) (*CountUnreadNotificationsResponse, error)
+
+	// MarkNotificationRead marks a notification of requester as read.
+	MarkNotificationRead(ctx context.Context, req *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error)
+
+	// MarkAllNotificationsRead marks all notifications of requester as read.
+	MarkAllNotificationsRead(ctx context.Context, req *MarkAllNotificationsReadRequest) (*MarkAllNotificationsReadResponse, error)
+
+	// NotifyTaskEvent records a notification of an event on a task for every
+	// user concerned, and delivers it to them. It is run by other use cases
+	// rather than requested by users.
+	NotifyTaskEvent(ctx context.Context, event *TaskEvent) error
+}
+
+// TaskEvent represent an event on a task which users are notified of.
+type TaskEvent struct {
+	Type entity.NotificationType
+	Task *entity.Task
+	// ActorID is the user causing the event, who is never notified of it.
+	// Zero means the event is caused by the system.
+	ActorID uint
+	// RecipientIDs are the users to notify. If empty, owner, assignees and
+	// watchers of the task are notified.
+	RecipientIDs []uint
+	// ReminderID is the reminder firing the event, if any. Its notification
+	// is recorded once however many times it is fired, and failing to
+	// deliver it fails the event so it can be fired again.
+	ReminderID uint
+	Body       string
+}
+
+// ListNotificationsRequest represent a request to get notifications of
+// requester.
+type ListNotificationsRequest struct {
+	UnreadOnly bool    `json:"-" query:"unread_only" field:"unread_only"`
+	Type       *string `json:"-" query:"type" field:"type" validate:"omitempty,oneof=assigned mentioned commented status_changed reminder"`
+	Page       int     `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit      int     `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListNotificationsResponse represent a response for listing notifications of
+// requester.
+type ListNotificationsResponse struct {
+	Items   []entity.Notification `json:"items"`
+	HasNext bool                  `json:"has_next"`
+	Page    uint                  `json:"page"`
+	Limit   uint                  `json:"limit"`
+}
+
+// CountUnreadNotificationsRequest represent a request to count unread
+// notifications of requester.
+type CountUnreadNotificationsRequest struct{}
+
+// CountUnreadNotificationsResponse represent a response for counting unread
+// notifications of requester.
+type CountUnreadNotificationsResponse struct {
+	Count int64 `json:"count"`
+}
+
+// MarkNotificationReadRequest represent a request to mark a notification as
+// read.
+type MarkNotificationReadRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// MarkNotificationReadResponse represent a response for marking a
+// notification as read.
+type MarkNotificationReadResponse struct {
+	Message string `json:"message"`
+}
+
+// MarkAllNotificationsReadRequest represent a request to mark all
+// notifications of requester as read.
+type MarkAllNotificationsReadRequest struct{}
+
+// MarkAllNotificationsReadResponse represent a response for marking all
+// notifications of requester as read.
+type MarkAllNotificationsReadResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ProjectService exposes all available use cases of project domain.
+type ProjectService interface {
+	// CreateProject creates a new project.
+	CreateProject(ctx context.Context, req *CreateProjectRequest) (*CreateProjectResponse, error)
+
+	// GetProject finds and returns a specific project.
+	GetProject(ctx context.Context, req *GetProjectRequest) (*GetProjectResponse, error)
+
+	// ListProjects finds and returns a list of projects requester owns or is
+	// member of.
+	ListProjects(ctx context.Context, req *ListProjectsRequest) (*ListProjectsResponse, error)
+
+	// UpdateProject updates a specific project.
+	UpdateProject(ctx context.Context, req *UpdateProjectRequest) (*UpdateProjectResponse, error)
+
+	// DeleteProject deletes a specific project along with its tasks.
+	DeleteProject(ctx context.Context, req *DeleteProjectRequest) (*DeleteProjectResponse, error)
+
+	// ArchiveProject archives a specific project along with its tasks.
+	ArchiveProject(ctx context.Context, req *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+
+	// AddProjectMember grants a role on a specific project to an user.
+	AddProjectMember(ctx context.Context, req *AddProjectMemberRequest) (*AddProjectMemberResponse, error)
+
+	// RemoveProjectMember revokes role of an user on a specific project.
+	RemoveProjectMember(ctx context.Context, req *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
+
+	// ListProjectMembers finds and returns a list of members of a specific
+	// project.
+	ListProjectMembers(ctx context.Context, req *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
+}
+
+// CreateProjectRequest represent a request to create a project.
+type CreateProjectRequest struct {
+	Name        string  `json:"name" validate:"required,max=256"`
+	Description string  `json:"description"`
+	WorkflowID  *string `json:"workflow_id"`
+}
+
+// CreateProjectResponse represent a response for creating a project.
+type CreateProjectResponse struct {
+	Message string `json:"message"`
+}
+
+// GetProjectRequest represent a request to get a project.
+type GetProjectRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// GetProjectResponse represent a response for getting a project.
+type GetProjectResponse struct {
+	*entity.Project
+}
+
+// ListProjectsRequest represent a request to get a list of projects.
+type ListProjectsRequest struct {
+	Status *string `json:"-" query:"status" field:"status" validate:"omitempty,oneof=active archived"`
+	Page   int     `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit  int     `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListProjectsResponse represent a response for listing projects.
+type ListProjectsResponse struct {
+	Items   []entity.Project `json:"items"`
+	HasNext bool             `json:"has_next"`
+	Page    uint             `json:"page"`
+	Limit   uint             `json:"limit"`
+}
+
+// UpdateProjectRequest represent a request to update a project.
+type UpdateProjectRequest struct {
+	ID          string  `json:"-"  param:"id" validate:"required"`
+	Name        *string `json:"name" validate:"omitempty,max=256"`
+	Description *string `json:"description"`
+	WorkflowID  *string `json:"workflow_id"`
+}
+
+// UpdateProjectResponse represent a response for updating a project.
+type UpdateProjectResponse struct {
+	Message string `json:"message"`
+}
+
+// DeleteProjectRequest represent a request to delete a project.
+type DeleteProjectRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// DeleteProjectResponse represent a response for deleting a project.
+type DeleteProjectResponse struct {
+	Message string `json:"message"`
+}
+
+// ArchiveProjectRequest represent a request to archive a project.
+type ArchiveProjectRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// ArchiveProjectResponse represent a response for archiving a project.
+type ArchiveProjectResponse struct {
+	Message string `json:"message"`
+}
+
+// AddProjectMemberRequest represent a request to grant a role on a project.
+type AddProjectMemberRequest struct {
+	ProjectID string `json:"-" param:"id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required"`
+	Role      string `json:"role" validate:"required,oneof=viewer editor admin"`
+}
+
+// AddProjectMemberResponse represent a response for granting a role on a
+// project.
+type AddProjectMemberResponse struct {
+	Message string `json:"message"`
+}
+
+// RemoveProjectMemberRequest represent a request to revoke a role on a
+// project.
+type RemoveProjectMemberRequest struct {
+	ProjectID string `param:"id" validate:"required"`
+	UserID    string `param:"user_id" validate:"required"`
+}
+
+// RemoveProjectMemberResponse represent a response for revoking a role on a
+// project.
+type RemoveProjectMemberResponse struct {
+	Message string `json:"message"`
+}
+
+// ListProjectMembersRequest represent a request to get a list of members of a
+// project.
+type ListProjectMembersRequest struct {
+	ProjectID string `json:"-" param:"id" validate:"required"`
+	Page      int    `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit     int    `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListProjectMembersResponse represent a response for listing members of a
+// project.
+type ListProjectMembersResponse struct {
+	Items   []entity.RoleBinding `json:"items"`
+	HasNext bool                 `json:"has_next"`
+	Page    uint                 `json:"page"`
+	Limit   uint                 `json:"limit"`
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// RecurrenceService exposes use cases of recurring tasks, which are run by
+// the system rather than requested by users.
+type RecurrenceService interface {
+	// CreateNextOccurrence creates next occurrence of a recurring task, unless
+	// it was already created or the series has ended, and reports whether it
+	// was created.
+	CreateNextOccurrence(ctx context.Context, task *entity.Task) (bool, error)
+
+	// CreateDueOccurrences creates next occurrences of recurring tasks of all
+	// tenants which are due before the given time, and returns how many were
+	// created.
+	CreateDueOccurrences(ctx context.Context, before time.Time) (int, error)
+}
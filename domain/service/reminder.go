@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ReminderService exposes all available use cases of reminder domain.
+type ReminderService interface {
+	// AddReminder sets a reminder of requester on a specific task.
+	AddReminder(ctx context.Context, req *AddReminderRequest) (*AddReminderResponse, error)
+
+	// ListReminders finds and returns reminders of requester on a specific
+	// task.
+	ListReminders(ctx context.Context, req *ListRemindersRequest) (*ListRemindersResponse, error)
+
+	// DeleteReminder deletes a reminder of requester.
+	DeleteReminder(ctx context.Context, req *DeleteReminderRequest) (*DeleteReminderResponse, error)
+
+	// FireDueReminders notifies users of reminders of all tenants which are
+	// due at the given time, and returns how many were fired. It is run by
+	// the system rather than requested by users.
+	FireDueReminders(ctx context.Context, now time.Time) (int, error)
+}
+
+// AddReminderRequest represent a request to set a reminder on a task. Either
+// an absolute time or minutes before due date of the task must be given.
+type AddReminderRequest struct {
+	TaskID           string     `json:"-" param:"id" validate:"required"`
+	RemindAt         *time.Time `json:"remind_at"`
+	BeforeDueMinutes *int       `json:"before_due_minutes" validate:"omitempty,min=0,max=43200"`
+}
+
+// AddReminderResponse represent a response for setting a reminder on a task.
+type AddReminderResponse struct {
+	Message string `json:"message"`
+}
+
+// ListRemindersRequest represent a request to get reminders on a task.
+type ListRemindersRequest struct {
+	TaskID string `param:"id" validate:"required"`
+}
+
+// ListRemindersResponse represent a response for listing reminders on a
+// task.
+type ListRemindersResponse struct {
+	Items []entity.Reminder `json:"items"`
+}
+
+// DeleteReminderRequest represent a request to delete a reminder.
+type DeleteReminderRequest struct {
+	TaskID     string `param:"id" validate:"required"`
+	ReminderID string `param:"reminder_id" validate:"required"`
+}
+
+// DeleteReminderResponse represent a response for deleting a reminder.
+type DeleteReminderResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,72 @@
+package serviceimpl
+
+import (
+	"time"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// taskChanges compares a task before and after an update, and returns one
+// activity of actor for each changed field.
+func taskChanges(before, after *entity.Task, actorID uint) []entity.Activity {
+	activities := make([]entity.Activity, 0)
+
+	changed := func(action entity.ActivityAction, field string, oldValue, newValue *string) {
+		if equalValues(oldValue, newValue) {
+			return
+		}
+
+		activities = append(activities, entity.Activity{
+			TaskID:   after.ID,
+			UserID:   actorID,
+			Action:   action,
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	changed(entity.ActivityActionUpdated, "title", stringValue(before.Title), stringValue(after.Title))
+	changed(entity.ActivityActionUpdated, "description", stringValue(before.Description), stringValue(after.Description))
+	changed(entity.ActivityActionStatusChanged, "status", stringValue(string(before.Status)), stringValue(string(after.Status)))
+	changed(entity.ActivityActionUpdated, "priority", stringValue(before.Priority.String()), stringValue(after.Priority.String()))
+	changed(entity.ActivityActionUpdated, "start_at", timeValue(before.StartAt), timeValue(after.StartAt))
+	changed(entity.ActivityActionUpdated, "due_at", timeValue(before.DueAt), timeValue(after.DueAt))
+	changed(entity.ActivityActionUpdated, "recurrence", before.Recurrence, after.Recurrence)
+
+	return activities
+}
+
+// taskActivity returns an activity of actor on a task, without field change.
+func taskActivity(taskID uint, actorID uint, action entity.ActivityAction) entity.Activity {
+	return entity.Activity{
+		TaskID: taskID,
+		UserID: actorID,
+		Action: action,
+	}
+}
+
+// stringValue returns value of a field as recorded in activities.
+func stringValue(s string) *string {
+	return &s
+}
+
+// timeValue returns value of a time field as recorded in activities, nil if
+// time is not set.
+func timeValue(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+// equalValues reports whether two recorded values are the same.
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
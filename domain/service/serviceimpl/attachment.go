@@ -0,0 +1,319 @@
+package serviceimpl
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/blob"
+	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// sniffSize is number of leading bytes of a file used to detect its type.
+const sniffSize = 512
+
+type attachmentService struct {
+	attachmentRepo store.AttachmentRepo
+	taskRepo       store.TaskRepo
+	blobStorage    blob.Storage
+	policy         entity.AttachmentPolicy
+	authorizer     service.Authorizer
+	userRepo       rpc.UserRepo
+	validator      validator.Validator
+}
+
+// NewAttachmentService creates and returns a new instance of
+// AttachmentService.
+func NewAttachmentService(
+	attachmentRepo store.AttachmentRepo,
+	taskRepo store.TaskRepo,
+	blobStorage blob.Storage,
+	policy entity.AttachmentPolicy,
+	authorizer service.Authorizer,
+	userRepo rpc.UserRepo,
+	validator validator.Validator,
+) service.AttachmentService {
+	return &attachmentService{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		blobStorage:    blobStorage,
+		policy:         policy,
+		authorizer:     authorizer,
+		userRepo:       userRepo,
+		validator:      validator,
+	}
+}
+
+// UploadAttachment attaches a new file to a specific task.
+func (s *attachmentService) UploadAttachment(ctx context.Context, req *service.UploadAttachmentRequest) (*service.UploadAttachmentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Type of file is detected from its content, never trusted from client
+	head := make([]byte, sniffSize)
+	n, err := io.ReadFull(req.File, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, kiterrors.WithStack(err)
+	}
+	head = head[:n]
+
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if !s.policy.AllowsType(mediaType) {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"file": fmt.Sprintf("file type %s is not allowed", mediaType)})
+	}
+
+	key, err := newAttachmentKey(task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read one byte more than allowed to tell an oversized file apart
+	content := io.LimitReader(io.MultiReader(bytes.NewReader(head), req.File), s.policy.MaxSize+1)
+
+	size, err := s.blobStorage.Put(ctx, key, content)
+	if err != nil {
+		return nil, err
+	}
+
+	if size > s.policy.MaxSize {
+		_ = s.blobStorage.Delete(ctx, key)
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"file": fmt.Sprintf("file must not be larger than %d bytes", s.policy.MaxSize)})
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	attachment := &entity.Attachment{
+		TaskID:      task.ID,
+		UserID:      requesterID,
+		FileName:    attachmentFileName(req.FileName),
+		ContentType: mediaType,
+		Size:        size,
+		StorageKey:  key,
+	}
+
+	if err := s.attachmentRepo.InsertOne(ctx, attachment); err != nil {
+		_ = s.blobStorage.Delete(ctx, key)
+		return nil, err
+	}
+
+	attachments := []entity.Attachment{*attachment}
+	if err := s.enrichAttachments(ctx, attachments); err != nil {
+		return nil, err
+	}
+
+	return &service.UploadAttachmentResponse{Attachment: &attachments[0]}, nil
+}
+
+// ListAttachments finds and returns all attachments of a specific task.
+func (s *attachmentService) ListAttachments(ctx context.Context, req *service.ListAttachmentsRequest) (*service.ListAttachmentsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionView)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.FindByTaskIDs(ctx, []uint{task.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enrichAttachments(ctx, attachments); err != nil {
+		return nil, err
+	}
+
+	return &service.ListAttachmentsResponse{Items: attachments}, nil
+}
+
+// DownloadAttachment opens content of a specific attachment.
+func (s *attachmentService) DownloadAttachment(ctx context.Context, req *service.DownloadAttachmentRequest) (*service.DownloadAttachmentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionView)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.findAttachment(ctx, task, req.AttachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.blobStorage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if err == blob.ErrObjectNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("attachment not found")
+		}
+
+		return nil, err
+	}
+
+	attachment.Mask()
+
+	return &service.DownloadAttachmentResponse{Attachment: attachment, Content: content}, nil
+}
+
+// DeleteAttachment deletes a specific attachment along with its file.
+func (s *attachmentService) DeleteAttachment(ctx context.Context, req *service.DeleteAttachmentRequest) (*service.DeleteAttachmentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.findAttachment(ctx, task, req.AttachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Metadata goes first, so the attachment is never listed without a file
+	if err := s.attachmentRepo.DeleteOne(ctx, attachment.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.blobStorage.Delete(ctx, attachment.StorageKey); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteAttachmentResponse{Message: "delete attachment successfully"}, nil
+}
+
+// findTask fetches a task by its masked id, making sure requester can do
+// action on it. Attachments of deleted tasks are not accessible anymore, and
+// the ones of archived tasks can only be viewed.
+func (s *attachmentService) findTask(ctx context.Context, taskID string, action entity.Action) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, action); err != nil {
+		return nil, err
+	}
+
+	if action != entity.ActionView && task.Status == entity.StatusArchived {
+		return nil, kiterrors.ErrForbidden.WithDetails("archived task can not be updated")
+	}
+
+	return task, nil
+}
+
+// findAttachment fetches an attachment of task by its masked id.
+func (s *attachmentService) findAttachment(ctx context.Context, task *entity.Task, attachmentID string) (*entity.Attachment, error) {
+	cUID, err := core.FromBase58(attachmentID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("attachment not found")
+	}
+
+	attachment, err := s.attachmentRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("attachment not found")
+		}
+
+		return nil, err
+	}
+
+	if attachment.TaskID != task.ID {
+		return nil, kiterrors.ErrNotFound.WithDetails("attachment not found")
+	}
+
+	return attachment, nil
+}
+
+// enrichAttachments fills uploaders of attachments and masks them.
+func (s *attachmentService) enrichAttachments(ctx context.Context, attachments []entity.Attachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	userIDs := make([]uint, len(attachments))
+
+	for i := range attachments {
+		userIDs[i] = attachments[i].UserID
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, uniqueIDs(userIDs))
+	if err != nil {
+		return err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, a := range attachments {
+		attachments[i].User = userMap[a.UserID]
+		attachments[i].Mask()
+	}
+
+	return nil
+}
+
+// newAttachmentKey generates a random storage key for a file of a task. Name
+// of the file is never part of the key.
+func newAttachmentKey(taskID uint) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", kiterrors.WithStack(err)
+	}
+
+	return fmt.Sprintf("tasks/%d/%s", taskID, hex.EncodeToString(b)), nil
+}
+
+// attachmentFileName strips directories which some clients send along with
+// name of the uploaded file.
+func attachmentFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	return path.Base(name)
+}
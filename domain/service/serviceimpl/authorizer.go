@@ -0,0 +1,242 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// roleAuthorizer authorizes requesters by their roles. Owners are admins of
+// their tasks and projects, assignees are editors of their tasks, users a task
+// is shared with get the role of their share, and other roles come from role
+// bindings on a project or on whole tenant.
+type roleAuthorizer struct {
+	roleBindingRepo  store.RoleBindingRepo
+	projectRepo      store.ProjectRepo
+	taskAssigneeRepo store.TaskAssigneeRepo
+	taskShareRepo    store.TaskShareRepo
+}
+
+// NewRoleAuthorizer creates and returns a new instance of Authorizer based on
+// roles.
+func NewRoleAuthorizer(
+	roleBindingRepo store.RoleBindingRepo,
+	projectRepo store.ProjectRepo,
+	taskAssigneeRepo store.TaskAssigneeRepo,
+	taskShareRepo store.TaskShareRepo,
+) service.Authorizer {
+	return &roleAuthorizer{
+		roleBindingRepo:  roleBindingRepo,
+		projectRepo:      projectRepo,
+		taskAssigneeRepo: taskAssigneeRepo,
+		taskShareRepo:    taskShareRepo,
+	}
+}
+
+// AuthorizeTask fails with kiterrors.ErrForbidden if requester is not allowed
+// to do action on task.
+func (a *roleAuthorizer) AuthorizeTask(ctx context.Context, task *entity.Task, action entity.Action) error {
+	requesterID := requesterIDFromContext(ctx)
+
+	role, err := a.roleOnTask(ctx, requesterID, task)
+	if err != nil {
+		return err
+	}
+
+	return checkRole(role, action, "task")
+}
+
+// FilterTaskViewers returns ids among userIDs of users allowed to view task,
+// in the same order.
+func (a *roleAuthorizer) FilterTaskViewers(ctx context.Context, task *entity.Task, userIDs []uint) ([]uint, error) {
+	result := make([]uint, 0, len(userIDs))
+
+	for _, userID := range userIDs {
+		role, err := a.roleOnTask(ctx, userID, task)
+		if err != nil {
+			return nil, err
+		}
+
+		if checkRole(role, entity.ActionView, "task") == nil {
+			result = append(result, userID)
+		}
+	}
+
+	return result, nil
+}
+
+// AuthorizeProject fails with kiterrors.ErrForbidden if requester is not
+// allowed to do action on project.
+func (a *roleAuthorizer) AuthorizeProject(ctx context.Context, project *entity.Project, action entity.Action) error {
+	requesterID := requesterIDFromContext(ctx)
+
+	role, err := a.roleOnProject(ctx, requesterID, project)
+	if err != nil {
+		return err
+	}
+
+	return checkRole(role, action, "project")
+}
+
+// AuthorizeWorkflow fails with kiterrors.ErrForbidden if requester is not
+// allowed to do action on workflow.
+func (a *roleAuthorizer) AuthorizeWorkflow(ctx context.Context, workflow *entity.Workflow, action entity.Action) error {
+	requesterID := requesterIDFromContext(ctx)
+
+	role, err := a.roleOnWorkflow(ctx, requesterID, workflow)
+	if err != nil {
+		return err
+	}
+
+	return checkRole(role, action, "workflow")
+}
+
+// AuthorizeTenant fails with kiterrors.ErrForbidden if requester is not
+// allowed to do action on whole tenant. Only role bindings on whole tenant
+// count.
+func (a *roleAuthorizer) AuthorizeTenant(ctx context.Context, action entity.Action) error {
+	requesterID := requesterIDFromContext(ctx)
+
+	roles, err := a.roleBindingRepo.FindRoles(ctx, requesterID, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkRole(entity.MaxRole(roles...), action, "tenant")
+}
+
+// ScopeTasks narrows filter to tasks requester is allowed to view. Requester
+// having a role on whole tenant can view all tasks of the tenant.
+func (a *roleAuthorizer) ScopeTasks(ctx context.Context, filter *entity.Filter) error {
+	requesterID := requesterIDFromContext(ctx)
+
+	roles, err := a.roleBindingRepo.FindRoles(ctx, requesterID, nil)
+	if err != nil {
+		return err
+	}
+
+	if entity.MaxRole(roles...).Includes(entity.RoleViewer) {
+		return nil
+	}
+
+	filter.VisibleTo = &requesterID
+
+	return nil
+}
+
+// roleOnTask resolves the highest role of user on task.
+func (a *roleAuthorizer) roleOnTask(ctx context.Context, userID uint, task *entity.Task) (entity.Role, error) {
+	if task.UserID == userID {
+		return entity.RoleAdmin, nil
+	}
+
+	roles := make([]entity.Role, 0)
+
+	if task.AssigneeIDs == nil {
+		assigneeMap, err := a.taskAssigneeRepo.FindUserIDsByTaskIDs(ctx, []uint{task.ID})
+		if err != nil {
+			return "", err
+		}
+		task.AssigneeIDs = assigneeMap[task.ID]
+	}
+
+	if task.IsAssignee(userID) {
+		roles = append(roles, entity.RoleEditor)
+	}
+
+	share, err := a.taskShareRepo.FindOne(ctx, task.ID, userID)
+	if err != nil && err != kiterrors.ErrRepoEntityNotFound {
+		return "", err
+	}
+
+	if share != nil {
+		roles = append(roles, share.Permission.Role())
+	}
+
+	if task.ProjectID != nil {
+		project, err := a.projectRepo.FindOne(ctx, *task.ProjectID)
+		if err != nil && err != kiterrors.ErrRepoEntityNotFound {
+			return "", err
+		}
+
+		if project != nil && project.UserID == userID {
+			return entity.RoleAdmin, nil
+		}
+	}
+
+	bound, err := a.roleBindingRepo.FindRoles(ctx, userID, task.ProjectID)
+	if err != nil {
+		return "", err
+	}
+
+	return entity.MaxRole(append(roles, bound...)...), nil
+}
+
+// roleOnProject resolves the highest role of user on project.
+func (a *roleAuthorizer) roleOnProject(ctx context.Context, userID uint, project *entity.Project) (entity.Role, error) {
+	if project.UserID == userID {
+		return entity.RoleAdmin, nil
+	}
+
+	roles, err := a.roleBindingRepo.FindRoles(ctx, userID, &project.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return entity.MaxRole(roles...), nil
+}
+
+// roleOnWorkflow resolves the highest role of user on workflow. Workflow of a
+// project takes roles on that project. Workflow of whole tenant is seen by
+// everyone in the tenant, and managed by its creator and tenant admins.
+func (a *roleAuthorizer) roleOnWorkflow(ctx context.Context, userID uint, workflow *entity.Workflow) (entity.Role, error) {
+	if workflow.ProjectID != nil {
+		project, err := a.projectRepo.FindOne(ctx, *workflow.ProjectID)
+		if err != nil {
+			if err == kiterrors.ErrRepoEntityNotFound {
+				return "", nil
+			}
+
+			return "", err
+		}
+
+		return a.roleOnProject(ctx, userID, project)
+	}
+
+	if workflow.UserID == userID {
+		return entity.RoleAdmin, nil
+	}
+
+	roles, err := a.roleBindingRepo.FindRoles(ctx, userID, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return entity.MaxRole(append(roles, entity.RoleViewer)...), nil
+}
+
+// checkRole fails with kiterrors.ErrForbidden, telling the missing role, if
+// role is not enough to do action on a resource of given kind.
+func checkRole(role entity.Role, action entity.Action, kind string) error {
+	required := action.RequiredRole()
+	if role.Includes(required) {
+		return nil
+	}
+
+	return kiterrors.ErrForbidden.WithDetails(fmt.Sprintf("%s role is required to %s the %s", required, action, kind))
+}
+
+// requesterIDFromContext returns local id of requester.
+func requesterIDFromContext(ctx context.Context) uint {
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+
+	return uint(id.GetLocalID())
+}
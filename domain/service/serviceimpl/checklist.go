@@ -0,0 +1,239 @@
+package serviceimpl
+
+import (
+	"context"
+
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+type checklistService struct {
+	checklistItemRepo store.ChecklistItemRepo
+	taskRepo          store.TaskRepo
+	authorizer        service.Authorizer
+	validator         validator.Validator
+}
+
+// NewChecklistService creates and returns a new instance of ChecklistService.
+func NewChecklistService(
+	checklistItemRepo store.ChecklistItemRepo,
+	taskRepo store.TaskRepo,
+	authorizer service.Authorizer,
+	validator validator.Validator,
+) service.ChecklistService {
+	return &checklistService{
+		checklistItemRepo: checklistItemRepo,
+		taskRepo:          taskRepo,
+		authorizer:        authorizer,
+		validator:         validator,
+	}
+}
+
+// AddChecklistItem adds a new item at the end of the checklist of a specific
+// task.
+func (s *checklistService) AddChecklistItem(ctx context.Context, req *service.AddChecklistItemRequest) (*service.AddChecklistItemResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &entity.ChecklistItem{
+		TaskID: task.ID,
+		Text:   req.Text,
+	}
+
+	if err := s.checklistItemRepo.InsertOne(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return &service.AddChecklistItemResponse{Message: "add checklist item successfully"}, nil
+}
+
+// ListChecklistItems finds and returns all items of the checklist of a
+// specific task.
+func (s *checklistService) ListChecklistItems(ctx context.Context, req *service.ListChecklistItemsRequest) (*service.ListChecklistItemsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionView)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.checklistItemRepo.FindByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		items[i].Mask()
+	}
+
+	return &service.ListChecklistItemsResponse{Items: items}, nil
+}
+
+// UpdateChecklistItem updates text of a specific item, or checks and unchecks
+// it.
+func (s *checklistService) UpdateChecklistItem(ctx context.Context, req *service.UpdateChecklistItemRequest) (*service.UpdateChecklistItemResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.findItem(ctx, task, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Text != nil && *req.Text != "" {
+		item.Text = *req.Text
+	}
+
+	if req.Checked != nil {
+		item.Checked = *req.Checked
+	}
+
+	if err := s.checklistItemRepo.UpdateOne(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return &service.UpdateChecklistItemResponse{Message: "update checklist item successfully"}, nil
+}
+
+// ReorderChecklistItems changes order of all items of the checklist of a
+// specific task.
+func (s *checklistService) ReorderChecklistItems(ctx context.Context, req *service.ReorderChecklistItemsRequest) (*service.ReorderChecklistItemsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	itemIDs, err := decodeUIDs(req.ItemIDs)
+	if err != nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"item_ids": err.Error()})
+	}
+
+	items, err := s.checklistItemRepo.FindByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// New order must list every item of the checklist exactly once
+	current := make(map[uint]bool, len(items))
+
+	for _, item := range items {
+		current[item.ID] = true
+	}
+
+	if len(itemIDs) != len(req.ItemIDs) || len(itemIDs) != len(items) {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"item_ids": "item_ids must list every item of the checklist once"})
+	}
+
+	for _, id := range itemIDs {
+		if !current[id] {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"item_ids": "item_ids must list every item of the checklist once"})
+		}
+	}
+
+	if err := s.checklistItemRepo.Reorder(ctx, task.ID, itemIDs); err != nil {
+		return nil, err
+	}
+
+	return &service.ReorderChecklistItemsResponse{Message: "reorder checklist items successfully"}, nil
+}
+
+// DeleteChecklistItem deletes a specific item.
+func (s *checklistService) DeleteChecklistItem(ctx context.Context, req *service.DeleteChecklistItemRequest) (*service.DeleteChecklistItemResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID, entity.ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.findItem(ctx, task, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checklistItemRepo.DeleteOne(ctx, item.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteChecklistItemResponse{Message: "delete checklist item successfully"}, nil
+}
+
+// findTask fetches a task by its masked id, making sure requester can do
+// action on it. Checklist of an archived task can only be viewed.
+func (s *checklistService) findTask(ctx context.Context, taskID string, action entity.Action) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, action); err != nil {
+		return nil, err
+	}
+
+	if action != entity.ActionView && task.Status == entity.StatusArchived {
+		return nil, kiterrors.ErrForbidden.WithDetails("archived task can not be updated")
+	}
+
+	return task, nil
+}
+
+// findItem fetches a checklist item of task by its masked id.
+func (s *checklistService) findItem(ctx context.Context, task *entity.Task, itemID string) (*entity.ChecklistItem, error) {
+	cUID, err := core.FromBase58(itemID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("checklist item not found")
+	}
+
+	item, err := s.checklistItemRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("checklist item not found")
+		}
+
+		return nil, err
+	}
+
+	if item.TaskID != task.ID {
+		return nil, kiterrors.ErrNotFound.WithDetails("checklist item not found")
+	}
+
+	return item, nil
+}
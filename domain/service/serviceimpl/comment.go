@@ -0,0 +1,351 @@
+package serviceimpl
+
+import (
+	"context"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+type commentService struct {
+	commentRepo         store.CommentRepo
+	mentionRepo         store.MentionRepo
+	taskWatcherRepo     store.TaskWatcherRepo
+	taskRepo            store.TaskRepo
+	authorizer          service.Authorizer
+	notificationService service.NotificationService
+	userRepo            rpc.UserRepo
+	validator           validator.Validator
+}
+
+// NewCommentService creates and returns a new instance of CommentService.
+func NewCommentService(
+	commentRepo store.CommentRepo,
+	mentionRepo store.MentionRepo,
+	taskWatcherRepo store.TaskWatcherRepo,
+	taskRepo store.TaskRepo,
+	authorizer service.Authorizer,
+	notificationService service.NotificationService,
+	userRepo rpc.UserRepo,
+	validator validator.Validator,
+) service.CommentService {
+	return &commentService{
+		commentRepo:         commentRepo,
+		mentionRepo:         mentionRepo,
+		taskWatcherRepo:     taskWatcherRepo,
+		taskRepo:            taskRepo,
+		authorizer:          authorizer,
+		notificationService: notificationService,
+		userRepo:            userRepo,
+		validator:           validator,
+	}
+}
+
+// CreateComment creates a new comment on a specific task, or a reply to
+// another comment.
+func (s *commentService) CreateComment(ctx context.Context, req *service.CreateCommentRequest) (*service.CreateCommentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	comment := &entity.Comment{
+		TaskID: task.ID,
+		UserID: requesterID,
+		Body:   req.Body,
+	}
+
+	if req.ParentID != nil && *req.ParentID != "" {
+		parent, err := s.findComment(ctx, task, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Replies to a reply go to its root comment, threads are one level deep
+		comment.ParentID = &parent.ID
+		if parent.ParentID != nil {
+			comment.ParentID = parent.ParentID
+		}
+	}
+
+	mentionIDs, err := resolveMentions(ctx, s.userRepo, s.authorizer, task, comment.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepo.InsertOne(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	if err := s.mentionRepo.ReplaceForComment(ctx, task.ID, comment.ID, mentionIDs); err != nil {
+		return nil, err
+	}
+
+	// Commenter follows the task to see replies
+	if err := s.taskWatcherRepo.InsertOne(ctx, task.ID, requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+		Type:    entity.NotificationTypeCommented,
+		Task:    task,
+		ActorID: requesterID,
+		Body:    comment.Body,
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(mentionIDs) > 0 {
+		if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+			Type:         entity.NotificationTypeMentioned,
+			Task:         task,
+			ActorID:      requesterID,
+			RecipientIDs: mentionIDs,
+			Body:         comment.Body,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &service.CreateCommentResponse{Message: "create comment successfully"}, nil
+}
+
+// ListComments finds and returns a list of comments of a specific task, with
+// their replies.
+func (s *commentService) ListComments(ctx context.Context, req *service.ListCommentsRequest) (*service.ListCommentsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	comments, err := s.commentRepo.FindRangeByTaskID(ctx, task.ID, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enrichComments(ctx, comments); err != nil {
+		return nil, err
+	}
+
+	return &service.ListCommentsResponse{
+		Items:   comments,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// UpdateComment updates a specific comment.
+func (s *commentService) UpdateComment(ctx context.Context, req *service.UpdateCommentRequest) (*service.UpdateCommentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.findComment(ctx, task, req.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Only comment author can do this
+	if requesterID != comment.UserID {
+		return nil, kiterrors.ErrForbidden.WithDetails("only author can edit their comment")
+	}
+
+	previousBody := comment.Body
+	comment.Body = req.Body
+
+	// Mentions are parsed again, so removed ones disappear
+	mentionIDs, err := resolveMentions(ctx, s.userRepo, s.authorizer, task, comment.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepo.UpdateOne(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	if err := s.mentionRepo.ReplaceForComment(ctx, task.ID, comment.ID, mentionIDs); err != nil {
+		return nil, err
+	}
+
+	if added := addedMentions(mentionIDs, previousBody); len(added) > 0 {
+		if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+			Type:         entity.NotificationTypeMentioned,
+			Task:         task,
+			ActorID:      requesterID,
+			RecipientIDs: added,
+			Body:         comment.Body,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &service.UpdateCommentResponse{Message: "update comment successfully"}, nil
+}
+
+// DeleteComment deletes a specific comment along with its replies.
+func (s *commentService) DeleteComment(ctx context.Context, req *service.DeleteCommentRequest) (*service.DeleteCommentResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.findComment(ctx, task, req.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Only comment author or task owner can do this
+	if requesterID != comment.UserID && requesterID != task.UserID {
+		return nil, kiterrors.ErrForbidden.WithDetails("only author or task owner can delete the comment")
+	}
+
+	if err := s.commentRepo.DeleteOne(ctx, comment.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteCommentResponse{Message: "delete comment successfully"}, nil
+}
+
+// findTask fetches a task by its masked id, making sure requester can view
+// it.
+func (s *commentService) findTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// findComment fetches a comment of task by its masked id.
+func (s *commentService) findComment(ctx context.Context, task *entity.Task, commentID string) (*entity.Comment, error) {
+	cUID, err := core.FromBase58(commentID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("comment not found")
+	}
+
+	comment, err := s.commentRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("comment not found")
+		}
+
+		return nil, err
+	}
+
+	if comment.TaskID != task.ID {
+		return nil, kiterrors.ErrNotFound.WithDetails("comment not found")
+	}
+
+	return comment, nil
+}
+
+// enrichComments fills replies and authors of comments and masks them.
+// Related data is fetched in batch for all comments.
+func (s *commentService) enrichComments(ctx context.Context, comments []entity.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	commentIDs := make([]uint, len(comments))
+
+	for i := range comments {
+		commentIDs[i] = comments[i].ID
+	}
+
+	replyMap, err := s.commentRepo.FindReplies(ctx, commentIDs)
+	if err != nil {
+		return err
+	}
+
+	// Get authors of comments and replies in one call
+	userIDs := make([]uint, 0, len(comments))
+
+	for i := range comments {
+		userIDs = append(userIDs, comments[i].UserID)
+		for _, r := range replyMap[comments[i].ID] {
+			userIDs = append(userIDs, r.UserID)
+		}
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, uniqueIDs(userIDs))
+	if err != nil {
+		return err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, c := range comments {
+		comments[i].User = userMap[c.UserID]
+		comments[i].Replies = replyMap[c.ID]
+		for j, r := range comments[i].Replies {
+			comments[i].Replies[j].User = userMap[r.UserID]
+		}
+		comments[i].Mask()
+	}
+
+	return nil
+}
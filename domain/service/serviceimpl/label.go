@@ -0,0 +1,171 @@
+package serviceimpl
+
+import (
+	"context"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+type labelService struct {
+	labelRepo store.LabelRepo
+	validator validator.Validator
+}
+
+// NewLabelService creates and returns a new instance of LabelService.
+func NewLabelService(
+	labelRepo store.LabelRepo,
+	validator validator.Validator,
+) service.LabelService {
+	return &labelService{
+		labelRepo: labelRepo,
+		validator: validator,
+	}
+}
+
+// CreateLabel creates a new label.
+func (s *labelService) CreateLabel(ctx context.Context, req *service.CreateLabelRequest) (*service.CreateLabelResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	label := &entity.Label{
+		UserID: requesterID,
+		Name:   req.Name,
+		Color:  req.Color,
+	}
+	if err := s.labelRepo.InsertOne(ctx, label); err != nil {
+		return nil, err
+	}
+
+	return &service.CreateLabelResponse{Message: "create label successfully"}, nil
+}
+
+// GetLabel finds and returns a specific label.
+func (s *labelService) GetLabel(ctx context.Context, req *service.GetLabelRequest) (*service.GetLabelResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	label, err := s.findOwnedLabel(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	label.Mask()
+
+	return &service.GetLabelResponse{Label: label}, nil
+}
+
+// ListLabels finds and returns a list of labels of requester.
+func (s *labelService) ListLabels(ctx context.Context, req *service.ListLabelsRequest) (*service.ListLabelsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	labels, err := s.labelRepo.FindRangeByCriteria(ctx, &entity.LabelFilter{UserID: &requesterID}, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range labels {
+		labels[i].Mask()
+	}
+
+	return &service.ListLabelsResponse{
+		Items:   labels,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// UpdateLabel updates a specific label.
+func (s *labelService) UpdateLabel(ctx context.Context, req *service.UpdateLabelRequest) (*service.UpdateLabelResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	label, err := s.findOwnedLabel(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && *req.Name != "" {
+		label.Name = *req.Name
+	}
+
+	if req.Color != nil && *req.Color != "" {
+		label.Color = *req.Color
+	}
+
+	if err := s.labelRepo.UpdateOne(ctx, label); err != nil {
+		return nil, err
+	}
+
+	return &service.UpdateLabelResponse{Message: "update label successfully"}, nil
+}
+
+// DeleteLabel deletes a specific label.
+func (s *labelService) DeleteLabel(ctx context.Context, req *service.DeleteLabelRequest) (*service.DeleteLabelResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	label, err := s.findOwnedLabel(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.labelRepo.DeleteOne(ctx, label.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteLabelResponse{Message: "delete label successfully"}, nil
+}
+
+// findOwnedLabel fetches a label by its masked id, making sure it belongs to
+// requester.
+func (s *labelService) findOwnedLabel(ctx context.Context, labelID string) (*entity.Label, error) {
+	cUID, err := core.FromBase58(labelID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	label, err := s.labelRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Only label owner can do this
+	if requesterID != label.UserID {
+		return nil, kiterrors.ErrForbidden.WithDetails("only owner can access their label")
+	}
+
+	return label, nil
+}
@@ -0,0 +1,60 @@
+package serviceimpl
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// resolveMentions parses mentions of text on task and returns ids of mentioned
+// users, skipping the ones which do not exist or can not view the task.
+func resolveMentions(ctx context.Context, userRepo rpc.UserRepo, authorizer service.Authorizer, task *entity.Task, text string) ([]uint, error) {
+	ids := entity.ParseMentions(text)
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	users, err := userRepo.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[uint]bool, len(users))
+
+	for _, u := range users {
+		existing[u.ID] = true
+	}
+
+	result := make([]uint, 0, len(ids))
+
+	for _, id := range ids {
+		if existing[id] {
+			result = append(result, id)
+		}
+	}
+
+	// Mentioning a user does not grant them access to the task
+	return authorizer.FilterTaskViewers(ctx, task, result)
+}
+
+// addedMentions returns ids of users among mentionIDs who were not mentioned
+// in previous text, so they are notified only once.
+func addedMentions(mentionIDs []uint, previous string) []uint {
+	mentioned := make(map[uint]bool)
+
+	for _, id := range entity.ParseMentions(previous) {
+		mentioned[id] = true
+	}
+
+	result := make([]uint, 0, len(mentionIDs))
+
+	for _, id := range mentionIDs {
+		if !mentioned[id] {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,277 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/notifier"
+	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// notificationTitles maps types of notifications to their titles, filled
+// with title of the task.
+var notificationTitles = map[entity.NotificationType]string{
+	entity.NotificationTypeAssigned:      "You were assigned to %s",
+	entity.NotificationTypeMentioned:     "You were mentioned in %s",
+	entity.NotificationTypeCommented:     "New comment on %s",
+	entity.NotificationTypeStatusChanged: "Status of %s changed",
+	entity.NotificationTypeReminder:      "Reminder: %s",
+}
+
+type notificationService struct {
+	notificationRepo store.NotificationRepo
+	taskAssigneeRepo store.TaskAssigneeRepo
+	taskWatcherRepo  store.TaskWatcherRepo
+	notifier         notifier.Notifier
+	authorizer       service.Authorizer
+	userRepo         rpc.UserRepo
+	validator        validator.Validator
+}
+
+// NewNotificationService creates and returns a new instance of
+// NotificationService.
+func NewNotificationService(
+	notificationRepo store.NotificationRepo,
+	taskAssigneeRepo store.TaskAssigneeRepo,
+	taskWatcherRepo store.TaskWatcherRepo,
+	notifier notifier.Notifier,
+	authorizer service.Authorizer,
+	userRepo rpc.UserRepo,
+	validator validator.Validator,
+) service.NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		taskAssigneeRepo: taskAssigneeRepo,
+		taskWatcherRepo:  taskWatcherRepo,
+		notifier:         notifier,
+		authorizer:       authorizer,
+		userRepo:         userRepo,
+		validator:        validator,
+	}
+}
+
+// ListNotifications finds and returns notifications of requester, newest
+// first.
+func (s *notificationService) ListNotifications(ctx context.Context, req *service.ListNotificationsRequest) (*service.ListNotificationsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	filter := &entity.NotificationFilter{
+		UserID:     requesterID,
+		UnreadOnly: req.UnreadOnly,
+	}
+
+	if req.Type != nil && *req.Type != "" {
+		t := entity.NotificationType(*req.Type)
+		filter.Type = &t
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	notifications, err := s.notificationRepo.FindRangeByCriteria(ctx, filter, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	actorIDs := make([]uint, 0, len(notifications))
+
+	for _, n := range notifications {
+		if n.ActorID != nil {
+			actorIDs = append(actorIDs, *n.ActorID)
+		}
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, uniqueIDs(actorIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, n := range notifications {
+		if n.ActorID != nil {
+			notifications[i].Actor = userMap[*n.ActorID]
+		}
+		notifications[i].Mask()
+	}
+
+	return &service.ListNotificationsResponse{
+		Items:   notifications,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// CountUnreadNotifications counts notifications of requester which are not
+// read yet.
+func (s *notificationService) CountUnreadNotifications(ctx context.Context, req *service.CountUnreadNotificationsRequest) (*service.CountUnreadNotificationsResponse, error) {
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	count, err := s.notificationRepo.CountUnread(ctx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.CountUnreadNotificationsResponse{Count: count}, nil
+}
+
+// MarkNotificationRead marks a notification of requester as read.
+func (s *notificationService) MarkNotificationRead(ctx context.Context, req *service.MarkNotificationReadRequest) (*service.MarkNotificationReadResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("notification not found")
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Notifications of other users are not visible at all
+	found, err := s.notificationRepo.MarkRead(ctx, requesterID, uint(cUID.GetLocalID()), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, kiterrors.ErrNotFound.WithDetails("notification not found")
+	}
+
+	return &service.MarkNotificationReadResponse{Message: "mark notification read successfully"}, nil
+}
+
+// MarkAllNotificationsRead marks all notifications of requester as read.
+func (s *notificationService) MarkAllNotificationsRead(ctx context.Context, req *service.MarkAllNotificationsReadRequest) (*service.MarkAllNotificationsReadResponse, error) {
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	if err := s.notificationRepo.MarkAllRead(ctx, requesterID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &service.MarkAllNotificationsReadResponse{Message: "mark all notifications read successfully"}, nil
+}
+
+// NotifyTaskEvent records a notification of an event on a task for every user
+// concerned who can still view the task, and delivers it to them.
+func (s *notificationService) NotifyTaskEvent(ctx context.Context, event *service.TaskEvent) error {
+	recipientIDs := event.RecipientIDs
+
+	if len(recipientIDs) == 0 {
+		var err error
+		recipientIDs, err = s.taskAudience(ctx, event.Task)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Watchers and others may have lost access since they were added
+	recipientIDs, err := s.authorizer.FilterTaskViewers(ctx, event.Task, uniqueIDs(recipientIDs))
+	if err != nil {
+		return err
+	}
+
+	var actorID *uint
+	if event.ActorID != 0 {
+		actorID = &event.ActorID
+	}
+
+	var reminderID *uint
+	if event.ReminderID != 0 {
+		reminderID = &event.ReminderID
+	}
+
+	notifications := make([]entity.Notification, 0, len(recipientIDs))
+
+	for _, userID := range recipientIDs {
+		// Nobody is notified of what they did themselves
+		if userID == event.ActorID {
+			continue
+		}
+
+		notifications = append(notifications, entity.Notification{
+			TenantID:   event.Task.TenantID,
+			UserID:     userID,
+			TaskID:     event.Task.ID,
+			ActorID:    actorID,
+			ReminderID: reminderID,
+			Type:       event.Type,
+			Title:      fmt.Sprintf(notificationTitles[event.Type], event.Task.Title),
+			Body:       event.Body,
+		})
+	}
+
+	if err := s.notificationRepo.InsertMany(ctx, notifications); err != nil {
+		return err
+	}
+
+	for _, n := range notifications {
+		key := fmt.Sprintf("notification:%d", n.ID)
+		if reminderID != nil {
+			// Stays the same each time the reminder is fired again
+			key = fmt.Sprintf("reminder:%d", *reminderID)
+		}
+
+		err := s.notifier.Notify(ctx, &notifier.Message{
+			Key:      key,
+			TenantID: n.TenantID,
+			UserID:   n.UserID,
+			TaskID:   n.TaskID,
+			Title:    n.Title,
+			Body:     n.Body,
+		})
+
+		// Inbox keeps every notification, so one failing to be delivered can
+		// still be read there. Reminders are fired again instead.
+		if err != nil && reminderID != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// taskAudience returns ids of users concerned by events of a task: its owner,
+// assignees and watchers.
+func (s *notificationService) taskAudience(ctx context.Context, task *entity.Task) ([]uint, error) {
+	assignees, err := s.taskAssigneeRepo.FindUserIDsByTaskIDs(ctx, []uint{task.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	watchers, err := s.taskWatcherRepo.FindUserIDsByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	audience := append([]uint{task.UserID}, assignees[task.ID]...)
+	return append(audience, watchers...), nil
+}
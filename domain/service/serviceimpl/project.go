@@ -0,0 +1,396 @@
+package serviceimpl
+
+import (
+	"context"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+type projectService struct {
+	projectRepo     store.ProjectRepo
+	workflowRepo    store.WorkflowRepo
+	roleBindingRepo store.RoleBindingRepo
+	authorizer      service.Authorizer
+	userRepo        rpc.UserRepo
+	validator       validator.Validator
+}
+
+// NewProjectService creates and returns a new instance of ProjectService.
+func NewProjectService(
+	projectRepo store.ProjectRepo,
+	workflowRepo store.WorkflowRepo,
+	roleBindingRepo store.RoleBindingRepo,
+	authorizer service.Authorizer,
+	userRepo rpc.UserRepo,
+	validator validator.Validator,
+) service.ProjectService {
+	return &projectService{
+		projectRepo:     projectRepo,
+		workflowRepo:    workflowRepo,
+		roleBindingRepo: roleBindingRepo,
+		authorizer:      authorizer,
+		userRepo:        userRepo,
+		validator:       validator,
+	}
+}
+
+// CreateProject creates a new project.
+func (s *projectService) CreateProject(ctx context.Context, req *service.CreateProjectRequest) (*service.CreateProjectResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	project := &entity.Project{
+		UserID:      requesterID,
+		Name:        req.Name,
+		Description: req.Description,
+		Status:      entity.ProjectStatusActive,
+	}
+
+	if req.WorkflowID != nil && *req.WorkflowID != "" {
+		workflowID, err := s.findWorkflowID(ctx, *req.WorkflowID, project)
+		if err != nil {
+			return nil, err
+		}
+		project.WorkflowID = &workflowID
+	}
+
+	if err := s.projectRepo.InsertOne(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return &service.CreateProjectResponse{Message: "create project successfully"}, nil
+}
+
+// GetProject finds and returns a specific project.
+func (s *projectService) GetProject(ctx context.Context, req *service.GetProjectRequest) (*service.GetProjectResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ID, entity.ActionView)
+	if err != nil {
+		return nil, err
+	}
+	project.Mask()
+
+	return &service.GetProjectResponse{Project: project}, nil
+}
+
+// ListProjects finds and returns a list of projects requester owns or is
+// member of.
+func (s *projectService) ListProjects(ctx context.Context, req *service.ListProjectsRequest) (*service.ListProjectsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	filter := &entity.ProjectFilter{MemberID: &requesterID}
+	if req.Status != nil {
+		status := entity.ProjectStatus(*req.Status)
+		filter.Status = &status
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	projects, err := s.projectRepo.FindRangeByCriteria(ctx, filter, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range projects {
+		projects[i].Mask()
+	}
+
+	return &service.ListProjectsResponse{
+		Items:   projects,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// UpdateProject updates a specific project.
+func (s *projectService) UpdateProject(ctx context.Context, req *service.UpdateProjectRequest) (*service.UpdateProjectResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ID, entity.ActionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if project.Status != entity.ProjectStatusActive {
+		return nil, kiterrors.ErrForbidden.WithDetails("only active project can be updated")
+	}
+
+	if req.Name != nil && *req.Name != "" {
+		project.Name = *req.Name
+	}
+
+	if req.Description != nil {
+		project.Description = *req.Description
+	}
+
+	if req.WorkflowID != nil {
+		project.WorkflowID = nil
+
+		if *req.WorkflowID != "" {
+			workflowID, err := s.findWorkflowID(ctx, *req.WorkflowID, project)
+			if err != nil {
+				return nil, err
+			}
+			project.WorkflowID = &workflowID
+		}
+	}
+
+	if err := s.projectRepo.UpdateOne(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return &service.UpdateProjectResponse{Message: "update project successfully"}, nil
+}
+
+// DeleteProject deletes a specific project along with its tasks.
+func (s *projectService) DeleteProject(ctx context.Context, req *service.DeleteProjectRequest) (*service.DeleteProjectResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ID, entity.ActionDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.projectRepo.DeleteOne(ctx, project.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteProjectResponse{Message: "delete project successfully"}, nil
+}
+
+// ArchiveProject archives a specific project along with its tasks.
+func (s *projectService) ArchiveProject(ctx context.Context, req *service.ArchiveProjectRequest) (*service.ArchiveProjectResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ID, entity.ActionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if project.Status != entity.ProjectStatusActive {
+		return nil, kiterrors.ErrForbidden.WithDetails("project has already archived")
+	}
+
+	if err := s.projectRepo.ArchiveOne(ctx, project.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.ArchiveProjectResponse{Message: "archive project successfully"}, nil
+}
+
+// AddProjectMember grants a role on a specific project to an user.
+func (s *projectService) AddProjectMember(ctx context.Context, req *service.AddProjectMemberRequest) (*service.AddProjectMemberResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ProjectID, entity.ActionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"user_id": err.Error()})
+	}
+	userID := uint(userUID.GetLocalID())
+
+	// Owner is always admin of their project
+	if userID == project.UserID {
+		return nil, kiterrors.ErrBadRequest.WithDetails("owner is already admin of the project")
+	}
+
+	// Make sure member is an existing user
+	users, err := s.userRepo.GetUsersByIDs(ctx, []uint{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, kiterrors.ErrNotFound.WithDetails("user not found")
+	}
+
+	if err := s.roleBindingRepo.UpsertOne(ctx, &entity.RoleBinding{
+		ProjectID: &project.ID,
+		UserID:    userID,
+		Role:      entity.Role(req.Role),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &service.AddProjectMemberResponse{Message: "add project member successfully"}, nil
+}
+
+// RemoveProjectMember revokes role of an user on a specific project.
+func (s *projectService) RemoveProjectMember(ctx context.Context, req *service.RemoveProjectMemberRequest) (*service.RemoveProjectMemberResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+	userID := uint(userUID.GetLocalID())
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Members can always leave a project, others need to manage it
+	action := entity.ActionManage
+	if userID == requesterID {
+		action = entity.ActionView
+	}
+
+	project, err := s.findProject(ctx, req.ProjectID, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.roleBindingRepo.DeleteOne(ctx, project.ID, userID); err != nil {
+		return nil, err
+	}
+
+	return &service.RemoveProjectMemberResponse{Message: "remove project member successfully"}, nil
+}
+
+// ListProjectMembers finds and returns a list of members of a specific
+// project.
+func (s *projectService) ListProjectMembers(ctx context.Context, req *service.ListProjectMembersRequest) (*service.ListProjectMembersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ProjectID, entity.ActionView)
+	if err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	members, err := s.roleBindingRepo.FindRangeByProject(ctx, project.ID, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, len(members))
+
+	for i := range members {
+		userIDs[i] = members[i].UserID
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, m := range members {
+		members[i].User = userMap[m.UserID]
+		members[i].Mask()
+	}
+
+	return &service.ListProjectMembersResponse{
+		Items:   members,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// findProject fetches a project by its masked id, making sure requester is
+// allowed to do action on it. Deleted project is seen as not existing.
+func (s *projectService) findProject(ctx context.Context, projectID string, action entity.Action) (*entity.Project, error) {
+	cUID, err := core.FromBase58(projectID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	project, err := s.projectRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if project.Status == entity.ProjectStatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeProject(ctx, project, action); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// findWorkflowID resolves a masked workflow id to its local id, making sure
+// requester can use the workflow for project. Project can only use workflows
+// of whole tenant or its own ones.
+func (s *projectService) findWorkflowID(ctx context.Context, workflowID string, project *entity.Project) (uint, error) {
+	cUID, err := core.FromBase58(workflowID)
+	if err != nil {
+		return 0, kiterrors.ErrNotFound.WithDetails("workflow not found")
+	}
+
+	workflow, err := s.workflowRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return 0, kiterrors.ErrNotFound.WithDetails("workflow not found")
+		}
+
+		return 0, err
+	}
+
+	if workflow.ProjectID != nil && *workflow.ProjectID != project.ID {
+		return 0, kiterrors.ErrBadRequest.WithDetails("workflow belongs to another project")
+	}
+
+	if err := s.authorizer.AuthorizeWorkflow(ctx, workflow, entity.ActionView); err != nil {
+		return 0, err
+	}
+
+	return workflow.ID, nil
+}
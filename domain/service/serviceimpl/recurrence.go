@@ -0,0 +1,174 @@
+package serviceimpl
+
+import (
+	"context"
+	"time"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// recurrenceBatchSize is the number of recurring tasks handled in one round.
+const recurrenceBatchSize = 100
+
+type recurrenceService struct {
+	taskRepo     store.TaskRepo
+	workflowRepo store.WorkflowRepo
+	activityRepo store.ActivityRepo
+}
+
+// NewRecurrenceService creates and returns a new instance of
+// RecurrenceService.
+func NewRecurrenceService(
+	taskRepo store.TaskRepo,
+	workflowRepo store.WorkflowRepo,
+	activityRepo store.ActivityRepo,
+) service.RecurrenceService {
+	return &recurrenceService{
+		taskRepo:     taskRepo,
+		workflowRepo: workflowRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// CreateNextOccurrence creates next occurrence of a recurring task, and
+// reports whether it was created.
+func (s *recurrenceService) CreateNextOccurrence(ctx context.Context, task *entity.Task) (bool, error) {
+	if task.Recurrence == nil || task.NextOccurrenceAt == nil {
+		return false, nil
+	}
+
+	next, err := s.nextOccurrence(ctx, task)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := s.taskRepo.InsertNextOccurrence(ctx, task, next)
+	if err != nil {
+		return false, err
+	}
+
+	// Task only loses its next occurrence once it is created
+	task.NextOccurrenceAt = nil
+
+	if !inserted {
+		return false, nil
+	}
+
+	// Occurrences are created on behalf of owner of the series
+	if err := s.activityRepo.InsertMany(ctx, []entity.Activity{
+		taskActivity(next.ID, next.UserID, entity.ActivityActionCreated),
+	}); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// CreateDueOccurrences creates next occurrences of recurring tasks of all
+// tenants which are due before the given time.
+func (s *recurrenceService) CreateDueOccurrences(ctx context.Context, before time.Time) (int, error) {
+	created := 0
+
+	for ctx.Err() == nil {
+		tasks, err := s.taskRepo.FindDueRecurring(ctx, before, recurrenceBatchSize)
+		if err != nil {
+			return created, err
+		}
+
+		if len(tasks) == 0 {
+			break
+		}
+
+		for i := range tasks {
+			// Work in tenant of the task, as its requester would
+			tenantCtx := kitcontext.WithUID(ctx, kitcontext.UID{Tid: tasks[i].TenantID})
+
+			inserted, err := s.CreateNextOccurrence(tenantCtx, &tasks[i])
+			if err != nil {
+				return created, err
+			}
+
+			// Occurrence created meanwhile by completing the task is not counted
+			if inserted {
+				created++
+			}
+		}
+	}
+
+	return created, nil
+}
+
+// nextOccurrence builds next occurrence of a recurring task. Dates are shifted
+// by the distance between both occurrences, and status starts over.
+func (s *recurrenceService) nextOccurrence(ctx context.Context, task *entity.Task) (*entity.Task, error) {
+	at := *task.NextOccurrenceAt
+	shift := at.Sub(task.RecurrenceAnchor())
+
+	seriesID := task.ID
+	if task.SeriesID != nil {
+		seriesID = *task.SeriesID
+	}
+
+	next := &entity.Task{
+		UserID:       task.UserID,
+		ParentID:     task.ParentID,
+		Title:        task.Title,
+		Description:  task.Description,
+		ProjectID:    task.ProjectID,
+		WorkflowID:   task.WorkflowID,
+		Status:       entity.StatusTodo,
+		Priority:     task.Priority,
+		Recurrence:   task.Recurrence,
+		SeriesID:     &seriesID,
+		OccurrenceAt: &at,
+		OccurrenceNo: task.OccurrenceNo + 1,
+	}
+	next.StatusCategory = next.Status.Category()
+
+	if task.StartAt != nil {
+		startAt := task.StartAt.Add(shift)
+		next.StartAt = &startAt
+	}
+
+	if task.DueAt != nil {
+		dueAt := task.DueAt.Add(shift)
+		next.DueAt = &dueAt
+	}
+
+	if task.WorkflowID != nil {
+		workflow, err := s.workflowRepo.FindOne(ctx, *task.WorkflowID)
+		if err != nil && err != kiterrors.ErrRepoEntityNotFound {
+			return nil, err
+		}
+
+		// Task falls back to built-in statuses if its workflow is gone
+		if initial := workflowInitialStatus(workflow); initial != nil {
+			next.Status = initial.Key
+			next.StatusCategory = initial.Category
+		} else {
+			next.WorkflowID = nil
+		}
+	}
+
+	// A late occurrence is the only catch-up, the series then resumes after now
+	if err := next.ScheduleRecurrence(time.Now()); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	return next, nil
+}
+
+// workflowInitialStatus returns the first status of workflow, nil if there is
+// no workflow or it has no status.
+func workflowInitialStatus(workflow *entity.Workflow) *entity.WorkflowStatus {
+	if workflow == nil {
+		return nil
+	}
+
+	return workflow.InitialStatus()
+}
@@ -0,0 +1,249 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+const (
+	// reminderBatchSize is the number of reminders fired in one round.
+	reminderBatchSize = 100
+
+	// reminderLease is how long a claimed reminder is kept from being claimed
+	// again, giving time to notify its user before it is retried.
+	reminderLease = 5 * time.Minute
+)
+
+type reminderService struct {
+	reminderRepo        store.ReminderRepo
+	taskRepo            store.TaskRepo
+	notificationService service.NotificationService
+	authorizer          service.Authorizer
+	validator           validator.Validator
+}
+
+// NewReminderService creates and returns a new instance of ReminderService.
+func NewReminderService(
+	reminderRepo store.ReminderRepo,
+	taskRepo store.TaskRepo,
+	notificationService service.NotificationService,
+	authorizer service.Authorizer,
+	validator validator.Validator,
+) service.ReminderService {
+	return &reminderService{
+		reminderRepo:        reminderRepo,
+		taskRepo:            taskRepo,
+		notificationService: notificationService,
+		authorizer:          authorizer,
+		validator:           validator,
+	}
+}
+
+// AddReminder sets a reminder of requester on a specific task.
+func (s *reminderService) AddReminder(ctx context.Context, req *service.AddReminderRequest) (*service.AddReminderResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if (req.RemindAt == nil) == (req.BeforeDueMinutes == nil) {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"remind_at": "either remind_at or before_due_minutes is required"})
+	}
+
+	if req.RemindAt != nil && !req.RemindAt.After(time.Now()) {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"remind_at": "remind_at must be in the future"})
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BeforeDueMinutes != nil && task.DueAt == nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"before_due_minutes": "task has no due date"})
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	reminder := &entity.Reminder{
+		TaskID:           task.ID,
+		UserID:           requesterID,
+		BeforeDueMinutes: req.BeforeDueMinutes,
+		RemindAt:         req.RemindAt,
+	}
+	reminder.Schedule(task.DueAt)
+
+	if err := s.reminderRepo.InsertOne(ctx, reminder); err != nil {
+		return nil, err
+	}
+
+	return &service.AddReminderResponse{Message: "add reminder successfully"}, nil
+}
+
+// ListReminders finds and returns reminders of requester on a specific task.
+func (s *reminderService) ListReminders(ctx context.Context, req *service.ListRemindersRequest) (*service.ListRemindersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	reminders, err := s.reminderRepo.FindByTaskID(ctx, task.ID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range reminders {
+		reminders[i].Mask()
+	}
+
+	return &service.ListRemindersResponse{Items: reminders}, nil
+}
+
+// DeleteReminder deletes a reminder of requester.
+func (s *reminderService) DeleteReminder(ctx context.Context, req *service.DeleteReminderRequest) (*service.DeleteReminderResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	cUID, err := core.FromBase58(req.ReminderID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("reminder not found")
+	}
+
+	reminder, err := s.reminderRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("reminder not found")
+		}
+
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Reminders are personal, ones of other users are not visible at all
+	if reminder.TaskID != task.ID || reminder.UserID != requesterID {
+		return nil, kiterrors.ErrNotFound.WithDetails("reminder not found")
+	}
+
+	if err := s.reminderRepo.DeleteOne(ctx, reminder.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteReminderResponse{Message: "delete reminder successfully"}, nil
+}
+
+// FireDueReminders notifies users of reminders of all tenants which are due
+// at the given time.
+func (s *reminderService) FireDueReminders(ctx context.Context, now time.Time) (int, error) {
+	fired := 0
+
+	for ctx.Err() == nil {
+		reminders, err := s.reminderRepo.ClaimDue(ctx, now, reminderLease, reminderBatchSize)
+		if err != nil {
+			return fired, err
+		}
+
+		if len(reminders) == 0 {
+			break
+		}
+
+		for i := range reminders {
+			// Task of a reminder may be gone by now, then there is nobody to
+			// remind of anything
+			if reminders[i].Task == nil {
+				if err := s.reminderRepo.MarkFired(ctx, reminders[i].ID, time.Now()); err != nil {
+					return fired, err
+				}
+				continue
+			}
+
+			// Reminder is fired on behalf of tenant of its task
+			tenantCtx := kitcontext.WithUID(ctx, kitcontext.UID{Tid: reminders[i].Task.TenantID})
+
+			// A reminder failing to be delivered is left claimed, so it is
+			// retried once its lease ends rather than blocking others
+			if err := s.notificationService.NotifyTaskEvent(tenantCtx, reminderEvent(&reminders[i])); err != nil {
+				continue
+			}
+
+			if err := s.reminderRepo.MarkFired(ctx, reminders[i].ID, time.Now()); err != nil {
+				return fired, err
+			}
+			fired++
+		}
+	}
+
+	return fired, nil
+}
+
+// findTask fetches a task by its masked id, making sure requester can view
+// it. Reminders are personal, so viewing a task is enough to set them.
+func (s *reminderService) findTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// reminderEvent returns the event of a fired reminder, which only its user
+// is notified of.
+func reminderEvent(reminder *entity.Reminder) *service.TaskEvent {
+	event := &service.TaskEvent{
+		Type:         entity.NotificationTypeReminder,
+		Task:         reminder.Task,
+		RecipientIDs: []uint{reminder.UserID},
+		ReminderID:   reminder.ID,
+	}
+
+	if task := reminder.Task; task != nil && task.DueAt != nil {
+		event.Body = fmt.Sprintf("Task is due at %s", task.DueAt.UTC().Format(time.RFC3339))
+	}
+
+	return event
+}
@@ -0,0 +1,242 @@
+package serviceimpl
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+// shareLinkTokenSize is number of random bytes of a share link token.
+const shareLinkTokenSize = 32
+
+type shareLinkService struct {
+	shareLinkRepo store.ShareLinkRepo
+	taskRepo      store.TaskRepo
+	authorizer    service.Authorizer
+	validator     validator.Validator
+}
+
+// NewShareLinkService creates and returns a new instance of ShareLinkService.
+func NewShareLinkService(
+	shareLinkRepo store.ShareLinkRepo,
+	taskRepo store.TaskRepo,
+	authorizer service.Authorizer,
+	validator validator.Validator,
+) service.ShareLinkService {
+	return &shareLinkService{
+		shareLinkRepo: shareLinkRepo,
+		taskRepo:      taskRepo,
+		authorizer:    authorizer,
+		validator:     validator,
+	}
+}
+
+// CreateShareLink mints a new share link of a specific task.
+func (s *shareLinkService) CreateShareLink(ctx context.Context, req *service.CreateShareLinkRequest) (*service.CreateShareLinkResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"expires_at": "expires_at must be in the future"})
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	token, err := newShareLinkToken()
+	if err != nil {
+		return nil, err
+	}
+
+	link := &entity.ShareLink{
+		TaskID:    task.ID,
+		UserID:    requesterID,
+		TokenHash: hashShareLinkToken(token),
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := s.shareLinkRepo.InsertOne(ctx, link); err != nil {
+		return nil, err
+	}
+	link.Mask()
+
+	return &service.CreateShareLinkResponse{
+		Message:   "create share link successfully",
+		ID:        link.FakeID,
+		Token:     token,
+		ExpiresAt: link.ExpiresAt,
+	}, nil
+}
+
+// ListShareLinks finds and returns share links of a specific task.
+func (s *shareLinkService) ListShareLinks(ctx context.Context, req *service.ListShareLinksRequest) (*service.ListShareLinksResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.shareLinkRepo.FindByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range links {
+		links[i].Mask()
+	}
+
+	return &service.ListShareLinksResponse{Items: links}, nil
+}
+
+// RevokeShareLink revokes a share link of a specific task.
+func (s *shareLinkService) RevokeShareLink(ctx context.Context, req *service.RevokeShareLinkRequest) (*service.RevokeShareLinkResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTask(ctx, req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	linkUID, err := core.FromBase58(req.LinkID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	link, err := s.shareLinkRepo.FindOne(ctx, uint(linkUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if link.TaskID != task.ID {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.shareLinkRepo.RevokeOne(ctx, link.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.RevokeShareLinkResponse{Message: "revoke share link successfully"}, nil
+}
+
+// GetSharedTask finds and returns the task of a share link, without requiring
+// requester to be authenticated. Unknown, revoked and expired links are all
+// seen as not existing.
+func (s *shareLinkService) GetSharedTask(ctx context.Context, req *service.GetSharedTaskRequest) (*service.GetSharedTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	link, err := s.shareLinkRepo.FindByTokenHash(ctx, hashShareLinkToken(req.Token))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	now := time.Now()
+	if !link.IsActive(now) {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	// Task is read on behalf of tenant of the link
+	ctx = kitcontext.WithUID(ctx, kitcontext.UID{Tid: link.TenantID})
+
+	task, err := s.taskRepo.FindOne(ctx, link.TaskID)
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.shareLinkRepo.InsertAccess(ctx, &entity.ShareLinkAccess{
+		LinkID:     link.ID,
+		RemoteAddr: req.RemoteAddr,
+		UserAgent:  req.UserAgent,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Only public fields of task leave through a share link
+	return &service.GetSharedTaskResponse{SharedTask: entity.NewSharedTask(task, now)}, nil
+}
+
+// findTask fetches a task by its masked id, making sure requester can manage
+// who can access it.
+func (s *shareLinkService) findTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionManage); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// newShareLinkToken generates a random, url safe token for a share link.
+func newShareLinkToken() (string, error) {
+	b := make([]byte, shareLinkTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", kiterrors.WithStack(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashShareLinkToken hashes token of a share link, so tokens are never stored
+// as is.
+func hashShareLinkToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
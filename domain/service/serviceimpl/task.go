@@ -2,34 +2,90 @@ package serviceimpl
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	kitcontext "github.com/quocdaitrn/golang-kit/context"
 	kiterrors "github.com/quocdaitrn/golang-kit/errors"
 	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/sirupsen/logrus"
 	"github.com/viettranx/service-context/core"
 
+	"github.com/quocdaitrn/cp-task/domain"
 	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/blob"
 	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
 	"github.com/quocdaitrn/cp-task/domain/repo/store"
 	"github.com/quocdaitrn/cp-task/domain/service"
 )
 
 type taskService struct {
-	taskRepo  store.TaskRepo
-	userRepo  rpc.UserRepo
-	validator validator.Validator
+	taskRepo            store.TaskRepo
+	labelRepo           store.LabelRepo
+	taskAssigneeRepo    store.TaskAssigneeRepo
+	taskDependencyRepo  store.TaskDependencyRepo
+	taskShareRepo       store.TaskShareRepo
+	taskWatcherRepo     store.TaskWatcherRepo
+	mentionRepo         store.MentionRepo
+	activityRepo        store.ActivityRepo
+	checklistItemRepo   store.ChecklistItemRepo
+	attachmentRepo      store.AttachmentRepo
+	reminderRepo        store.ReminderRepo
+	blobStorage         blob.Storage
+	workflowRepo        store.WorkflowRepo
+	projectRepo         store.ProjectRepo
+	authorizer          service.Authorizer
+	recurrenceService   service.RecurrenceService
+	notificationService service.NotificationService
+	webhookService      service.WebhookService
+	userRepo            rpc.UserRepo
+	validator           validator.Validator
 }
 
 // NewTaskService creates and returns a new instance of TaskService.
 func NewTaskService(
 	taskRepo store.TaskRepo,
+	labelRepo store.LabelRepo,
+	taskAssigneeRepo store.TaskAssigneeRepo,
+	taskDependencyRepo store.TaskDependencyRepo,
+	taskShareRepo store.TaskShareRepo,
+	taskWatcherRepo store.TaskWatcherRepo,
+	mentionRepo store.MentionRepo,
+	activityRepo store.ActivityRepo,
+	checklistItemRepo store.ChecklistItemRepo,
+	attachmentRepo store.AttachmentRepo,
+	reminderRepo store.ReminderRepo,
+	blobStorage blob.Storage,
+	workflowRepo store.WorkflowRepo,
+	projectRepo store.ProjectRepo,
+	authorizer service.Authorizer,
+	recurrenceService service.RecurrenceService,
+	notificationService service.NotificationService,
+	webhookService service.WebhookService,
 	userRepo rpc.UserRepo,
 	validator validator.Validator,
 ) service.TaskService {
 	return &taskService{
-		taskRepo:  taskRepo,
-		userRepo:  userRepo,
-		validator: validator,
+		taskRepo:            taskRepo,
+		labelRepo:           labelRepo,
+		taskAssigneeRepo:    taskAssigneeRepo,
+		taskDependencyRepo:  taskDependencyRepo,
+		taskShareRepo:       taskShareRepo,
+		taskWatcherRepo:     taskWatcherRepo,
+		mentionRepo:         mentionRepo,
+		activityRepo:        activityRepo,
+		checklistItemRepo:   checklistItemRepo,
+		attachmentRepo:      attachmentRepo,
+		reminderRepo:        reminderRepo,
+		blobStorage:         blobStorage,
+		workflowRepo:        workflowRepo,
+		projectRepo:         projectRepo,
+		authorizer:          authorizer,
+		recurrenceService:   recurrenceService,
+		notificationService: notificationService,
+		webhookService:      webhookService,
+		userRepo:            userRepo,
+		validator:           validator,
 	}
 }
 
@@ -43,16 +99,138 @@ func (s *taskService) CreateNewTask(ctx context.Context, req *service.CreateNewT
 	id, _ := core.FromBase58(uid.Sub)
 	requesterID := uint(id.GetLocalID())
 
+	if err := validateSchedule(req.StartAt, req.DueAt); err != nil {
+		return nil, err
+	}
+
 	task := &entity.Task{
-		UserID:      requesterID,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      entity.StatusDoing,
+		UserID:         requesterID,
+		Title:          req.Title,
+		Description:    req.Description,
+		Status:         entity.StatusDoing,
+		StatusCategory: entity.StatusDoing.Category(),
+		StartAt:        req.StartAt,
+		DueAt:          req.DueAt,
+		CreatedAt:      time.Now(),
+	}
+
+	if req.ParentID != nil && *req.ParentID != "" {
+		parent, err := s.findParentTask(ctx, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Adding a subtask changes its parent
+		if err := s.authorizer.AuthorizeTask(ctx, parent, entity.ActionEdit); err != nil {
+			return nil, err
+		}
+
+		task.ParentID = &parent.ID
+	}
+
+	var workflow *entity.Workflow
+
+	if req.WorkflowID != nil && *req.WorkflowID != "" {
+		var err error
+		workflow, err = s.findWorkflow(ctx, *req.WorkflowID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ProjectID != nil && *req.ProjectID != "" {
+		project, err := s.findProject(ctx, *req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only project editors can add tasks to it, and only while it is active
+		if err := s.authorizer.AuthorizeProject(ctx, project, entity.ActionEdit); err != nil {
+			return nil, err
+		}
+
+		if project.Status != entity.ProjectStatusActive {
+			return nil, kiterrors.ErrForbidden.WithDetails("only active project can receive new tasks")
+		}
+
+		task.ProjectID = &project.ID
+
+		// Task follows workflow of its project unless one is given explicitly
+		if workflow == nil && project.WorkflowID != nil {
+			workflow, err = s.workflowRepo.FindOne(ctx, *project.WorkflowID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Task of a workflow starts with the first status of that workflow
+	if workflow != nil {
+		if workflow.ProjectID != nil && (task.ProjectID == nil || *task.ProjectID != *workflow.ProjectID) {
+			return nil, kiterrors.ErrBadRequest.WithDetails("workflow of a project can only be used by tasks of that project")
+		}
+
+		initial := workflow.InitialStatus()
+		if initial == nil {
+			return nil, kiterrors.ErrBadRequest.WithDetails("workflow has no status")
+		}
+
+		task.WorkflowID = &workflow.ID
+		task.Status = initial.Key
+		task.StatusCategory = initial.Category
+	}
+
+	if req.Priority != nil {
+		priority, err := entity.ParsePriority(*req.Priority)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"priority": err.Error()})
+		}
+		task.Priority = priority
 	}
+
+	if req.Recurrence != nil && *req.Recurrence != "" {
+		if err := setRecurrence(task, *req.Recurrence); err != nil {
+			return nil, err
+		}
+	}
+
+	mentionIDs, err := resolveMentions(ctx, s.userRepo, s.authorizer, task, task.Description)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.taskRepo.InsertOne(ctx, task); err != nil {
 		return nil, err
 	}
 
+	if err := s.mentionRepo.ReplaceForTask(ctx, task.ID, mentionIDs); err != nil {
+		return nil, err
+	}
+
+	// Creator follows their task from the start
+	if err := s.taskWatcherRepo.InsertOne(ctx, task.ID, requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.activityRepo.InsertMany(ctx, []entity.Activity{
+		taskActivity(task.ID, requesterID, entity.ActivityActionCreated),
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(mentionIDs) > 0 {
+		if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+			Type:         entity.NotificationTypeMentioned,
+			Task:         task,
+			ActorID:      requesterID,
+			RecipientIDs: mentionIDs,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	s.dispatchWebhooks(ctx, entity.WebhookEventTaskCreated, task, requesterID)
+
 	return &service.CreateNewTaskResponse{Message: "create task successfully"}, nil
 }
 
@@ -63,12 +241,16 @@ func (s *taskService) GetTask(ctx context.Context, req *service.GetTaskRequest)
 	}
 
 	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
 
 	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
 	if err != nil {
-		if err == kiterrors.ErrRepoCacheNotFound {
+		if err == kiterrors.ErrRepoEntityNotFound {
 			return nil, kiterrors.ErrNotFound
 		}
+
 		return nil, err
 	}
 
@@ -76,15 +258,16 @@ func (s *taskService) GetTask(ctx context.Context, req *service.GetTaskRequest)
 		return nil, kiterrors.ErrNotFound
 	}
 
-	// Get extra infos: User
-	user, err := s.userRepo.GetUserByID(ctx, task.UserID)
-	if err != nil {
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	tasks := []entity.Task{*task}
+	if err := s.enrichTasks(ctx, tasks); err != nil {
 		return nil, err
 	}
-	task.User = user
-	task.Mask()
 
-	return &service.GetTaskResponse{Task: task}, nil
+	return &service.GetTaskResponse{Task: &tasks[0]}, nil
 }
 
 // ListTasks finds and returns a list of tasks.
@@ -93,43 +276,122 @@ func (s *taskService) ListTasks(ctx context.Context, req *service.ListTasksReque
 		return nil, kiterrors.WithStack(err)
 	}
 
-	paging := &core.Paging{
-		Page:  req.Page,
-		Limit: req.Limit,
+	filter := &entity.Filter{
+		UserID:  req.UserID,
+		Status:  req.Status,
+		Overdue: req.Overdue,
+		Blocked: req.Blocked,
 	}
-	tasks, err := s.taskRepo.FindRangeByCriteria(ctx,
-		&entity.Filter{
-			UserID: req.UserID,
-			Status: req.Status,
-		},
-		paging,
-	)
-	if err != nil {
-		return nil, err
+
+	if req.ProjectID != nil {
+		projectUID, err := core.FromBase58(*req.ProjectID)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"project_id": err.Error()})
+		}
+		projectID := uint(projectUID.GetLocalID())
+		filter.ProjectID = &projectID
 	}
 
-	// Get extra infos: User
-	userIDs := make([]uint, len(tasks))
+	if req.StatusCategory != nil {
+		category := entity.StatusCategory(*req.StatusCategory)
+		filter.StatusCategory = &category
+	}
 
-	for i := range userIDs {
-		userIDs[i] = tasks[i].UserID
+	// Archived tasks are left out unless they are asked for
+	archived := false
+	filter.Archived = &archived
+	if req.IncludeArchived != nil && *req.IncludeArchived {
+		filter.Archived = nil
+	}
+	if req.ArchivedOnly != nil && *req.ArchivedOnly {
+		archived = true
+		filter.Archived = &archived
 	}
 
-	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
-	if err != nil {
+	if req.AssignedToMe != nil && *req.AssignedToMe {
+		uid := kitcontext.UIDFromContext(ctx)
+		id, _ := core.FromBase58(uid.Sub)
+		requesterID := uint(id.GetLocalID())
+		filter.AssigneeID = &requesterID
+	}
+
+	if req.MentionsMe != nil && *req.MentionsMe {
+		uid := kitcontext.UIDFromContext(ctx)
+		id, _ := core.FromBase58(uid.Sub)
+		requesterID := uint(id.GetLocalID())
+		filter.MentionedID = &requesterID
+	}
+
+	if req.Watching != nil && *req.Watching {
+		uid := kitcontext.UIDFromContext(ctx)
+		id, _ := core.FromBase58(uid.Sub)
+		requesterID := uint(id.GetLocalID())
+		filter.WatcherID = &requesterID
+	}
+
+	if req.Priority != nil {
+		priority, err := entity.ParsePriority(*req.Priority)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"priority": err.Error()})
+		}
+		filter.Priority = &priority
+	}
+
+	if req.DueBefore != nil {
+		dueBefore, err := time.Parse(time.RFC3339, *req.DueBefore)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"due_before": err.Error()})
+		}
+		filter.DueBefore = &dueBefore
+	}
+
+	if req.DueAfter != nil {
+		dueAfter, err := time.Parse(time.RFC3339, *req.DueAfter)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"due_after": err.Error()})
+		}
+		filter.DueAfter = &dueAfter
+	}
+
+	if len(req.LabelIDs) > 0 {
+		labelIDs, err := decodeUIDs(req.LabelIDs)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"label_ids": err.Error()})
+		}
+		filter.LabelIDs = labelIDs
+		filter.LabelMatch = entity.LabelMatchAny
+		if req.LabelMatch != "" {
+			filter.LabelMatch = entity.LabelMatch(req.LabelMatch)
+		}
+	}
+
+	// Only tasks requester can view are listed
+	if err := s.authorizer.ScopeTasks(ctx, filter); err != nil {
 		return nil, err
 	}
 
-	// For speed up mapping data
-	userMap := make(map[uint]*entity.SimpleUser)
+	var sort *entity.Sort
+	if req.SortBy != "" {
+		sort = &entity.Sort{
+			Field:     entity.SortField(req.SortBy),
+			Direction: entity.SortAsc,
+		}
+		if req.SortOrder != "" {
+			sort.Direction = entity.SortDirection(req.SortOrder)
+		}
+	}
 
-	for i, u := range users {
-		userMap[u.ID] = &users[i]
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	tasks, err := s.taskRepo.FindRangeByCriteria(ctx, filter, sort, paging)
+	if err != nil {
+		return nil, err
 	}
 
-	for i, t := range tasks {
-		tasks[i].User = userMap[t.UserID]
-		tasks[i].Mask()
+	if err := s.enrichTasks(ctx, tasks); err != nil {
+		return nil, err
 	}
 
 	return &service.ListTasksResponse{
@@ -158,20 +420,22 @@ func (s *taskService) UpdateTask(ctx context.Context, req *service.UpdateTaskReq
 		return nil, err
 	}
 
-	uid := kitcontext.UIDFromContext(ctx)
-	id, _ := core.FromBase58(uid.Sub)
-	requesterID := uint(id.GetLocalID())
+	// Deleted task can only be seen as not existing
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
 
-	// Only task owner can do this
-	if requesterID != task.UserID {
-		return nil, kiterrors.ErrForbidden.WithDetails("only owner can update their task")
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
 	}
 
-	// Only update task with doing status
-	if task.Status != entity.StatusDoing {
-		return nil, kiterrors.ErrForbidden.WithDetails("only update task with doing status")
+	if task.Status == entity.StatusArchived {
+		return nil, kiterrors.ErrForbidden.WithDetails("archived task can not be updated")
 	}
 
+	// Keep task as it was to record what changes
+	before := *task
+
 	if req.Title != nil && *req.Title != "" {
 		task.Title = *req.Title
 	}
@@ -180,14 +444,134 @@ func (s *taskService) UpdateTask(ctx context.Context, req *service.UpdateTaskReq
 		task.Description = *req.Description
 	}
 
-	if req.Status != nil && *req.Status != "" {
-		task.Status = entity.Status(*req.Status)
+	if req.Status != nil && *req.Status != "" && entity.Status(*req.Status) != task.Status {
+		status := entity.Status(*req.Status)
+
+		category, err := s.transitStatus(ctx, task, status)
+		if err != nil {
+			return nil, err
+		}
+
+		// Task can not be finished while any of its blockers is unfinished,
+		// but it can always be cancelled
+		if category == entity.StatusCategoryClosed && status != entity.StatusCancelled {
+			if err := s.checkNotBlocked(ctx, task.ID); err != nil {
+				return nil, err
+			}
+		}
+
+		task.Status = status
+		task.StatusCategory = category
+	}
+
+	if req.Priority != nil {
+		priority, err := entity.ParsePriority(*req.Priority)
+		if err != nil {
+			return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"priority": err.Error()})
+		}
+		task.Priority = priority
+	}
+
+	if req.StartAt != nil {
+		task.StartAt = req.StartAt
+	}
+
+	if req.DueAt != nil {
+		task.DueAt = req.DueAt
+	}
+
+	if err := validateSchedule(task.StartAt, task.DueAt); err != nil {
+		return nil, err
+	}
+
+	// Empty recurrence stops the series at this occurrence
+	if req.Recurrence != nil && *req.Recurrence != "" {
+		if err := setRecurrence(task, *req.Recurrence); err != nil {
+			return nil, err
+		}
+	} else if req.Recurrence != nil || req.StartAt != nil || req.DueAt != nil {
+		if req.Recurrence != nil {
+			task.Recurrence = nil
+		}
+
+		if err := task.ScheduleRecurrence(time.Now()); err != nil {
+			return nil, kiterrors.WithStack(err)
+		}
+	}
+
+	// Next occurrence of a task is created at most once, so a series which
+	// already went past this task is not scheduled again
+	if before.Recurrence != nil && before.NextOccurrenceAt == nil {
+		task.NextOccurrenceAt = nil
+	}
+
+	// Mentions are parsed again, so removed ones disappear
+	mentionIDs, err := resolveMentions(ctx, s.userRepo, s.authorizer, task, task.Description)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.taskRepo.UpdateOne(ctx, task); err != nil {
 		return nil, err
 	}
 
+	if err := s.mentionRepo.ReplaceForTask(ctx, task.ID, mentionIDs); err != nil {
+		return nil, err
+	}
+
+	// Reminders set before due date follow it
+	if !equalValues(timeValue(before.DueAt), timeValue(task.DueAt)) {
+		if err := s.reminderRepo.RescheduleForTask(ctx, task.ID, task.DueAt); err != nil {
+			return nil, err
+		}
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	changes := taskChanges(&before, task, requesterID)
+
+	if err := s.activityRepo.InsertMany(ctx, changes); err != nil {
+		return nil, err
+	}
+
+	if task.Status != before.Status {
+		if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+			Type:    entity.NotificationTypeStatusChanged,
+			Task:    task,
+			ActorID: requesterID,
+			Body:    fmt.Sprintf("Status changed from %s to %s", before.Status, task.Status),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if added := addedMentions(mentionIDs, before.Description); len(added) > 0 {
+		if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+			Type:         entity.NotificationTypeMentioned,
+			Task:         task,
+			ActorID:      requesterID,
+			RecipientIDs: added,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// Nothing is sent when the update changed no field
+	if len(changes) > 0 {
+		s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, task, requesterID)
+	}
+
+	// Completing an occurrence brings up the next one, unless the scheduler
+	// already created it ahead of time
+	if task.StatusCategory == entity.StatusCategoryClosed && before.StatusCategory != entity.StatusCategoryClosed &&
+		task.Status != entity.StatusCancelled {
+		if _, err := s.recurrenceService.CreateNextOccurrence(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+
 	return &service.UpdateTaskResponse{Message: "update task successfully"}, nil
 }
 
@@ -198,6 +582,9 @@ func (s *taskService) DeleteTask(ctx context.Context, req *service.DeleteTaskReq
 	}
 
 	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
 	localID := uint(cUID.GetLocalID())
 
 	// Get task data, without extra infos
@@ -210,23 +597,1554 @@ func (s *taskService) DeleteTask(ctx context.Context, req *service.DeleteTaskReq
 		return nil, err
 	}
 
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionDelete); err != nil {
+		return nil, err
+	}
+
+	// Task already in trash is seen as not existing
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.taskRepo.DeleteOne(ctx, localID); err != nil {
+		return nil, err
+	}
+
 	uid := kitcontext.UIDFromContext(ctx)
 	id, _ := core.FromBase58(uid.Sub)
 	requesterID := uint(id.GetLocalID())
 
-	// Only task owner can do this
-	if requesterID != task.UserID {
-		return nil, kiterrors.ErrForbidden.WithDetails("only owner can delete their task")
+	if err := s.activityRepo.InsertMany(ctx, []entity.Activity{
+		taskActivity(task.ID, requesterID, entity.ActivityActionDeleted),
+	}); err != nil {
+		return nil, err
+	}
+
+	// Integrations get the task as it is in trash
+	deleted, err := s.taskRepo.FindOne(ctx, localID)
+	if err != nil {
+		logrus.Errorf("Can not dispatch %s webhooks of task %d, error: %s", entity.WebhookEventTaskDeleted, localID, err.Error())
+	} else {
+		s.dispatchWebhooks(ctx, entity.WebhookEventTaskDeleted, deleted, requesterID)
+	}
+
+	return &service.DeleteTaskResponse{Message: "delete task successfully"}, nil
+}
+
+// AddTaskLabels attaches labels to a specific task.
+func (s *taskService) AddTaskLabels(ctx context.Context, req *service.AddTaskLabelsRequest) (*service.AddTaskLabelsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	labelIDs, err := decodeUIDs(req.LabelIDs)
+	if err != nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"label_ids": err.Error()})
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
 	}
 
-	// Only delete task with doing status
 	if task.Status == entity.StatusDeleted {
-		return nil, kiterrors.ErrForbidden.WithDetails("task has already deleted")
+		return nil, kiterrors.ErrNotFound
 	}
 
-	if err := s.taskRepo.DeleteOne(ctx, localID); err != nil {
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
 		return nil, err
 	}
 
-	return &service.DeleteTaskResponse{Message: "delete task successfully"}, nil
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Only labels of requester can be attached
+	labels, err := s.labelRepo.FindByIDs(ctx, labelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(labels) != len(labelIDs) {
+		return nil, kiterrors.ErrNotFound.WithDetails("label not found")
+	}
+
+	for _, l := range labels {
+		if l.UserID != requesterID {
+			return nil, kiterrors.ErrForbidden.WithDetails("only owner can use their label")
+		}
+	}
+
+	if err := s.labelRepo.AttachToTask(ctx, task.ID, labelIDs); err != nil {
+		return nil, err
+	}
+
+	return &service.AddTaskLabelsResponse{Message: "add labels to task successfully"}, nil
+}
+
+// RemoveTaskLabel detaches a label from a specific task.
+func (s *taskService) RemoveTaskLabel(ctx context.Context, req *service.RemoveTaskLabelRequest) (*service.RemoveTaskLabelResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	labelUID, err := core.FromBase58(req.LabelID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	if err := s.labelRepo.DetachFromTask(ctx, task.ID, uint(labelUID.GetLocalID())); err != nil {
+		return nil, err
+	}
+
+	return &service.RemoveTaskLabelResponse{Message: "remove label from task successfully"}, nil
+}
+
+// AssignTask assigns users to a specific task.
+func (s *taskService) AssignTask(ctx context.Context, req *service.AssignTaskRequest) (*service.AssignTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	userIDs, err := decodeUIDs(req.UserIDs)
+	if err != nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"user_ids": err.Error()})
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	// Make sure all assignees are existing users
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) != len(userIDs) {
+		return nil, kiterrors.ErrNotFound.WithDetails("user not found")
+	}
+
+	assignees, err := s.taskAssigneeRepo.FindUserIDsByTaskIDs(ctx, []uint{task.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.taskAssigneeRepo.InsertMany(ctx, task.ID, userIDs); err != nil {
+		return nil, err
+	}
+
+	// Only users newly assigned are notified
+	assigned := make(map[uint]bool)
+
+	for _, userID := range assignees[task.ID] {
+		assigned[userID] = true
+	}
+
+	newAssigneeIDs := make([]uint, 0, len(userIDs))
+
+	for _, userID := range userIDs {
+		if !assigned[userID] {
+			newAssigneeIDs = append(newAssigneeIDs, userID)
+		}
+	}
+
+	if len(newAssigneeIDs) > 0 {
+		uid := kitcontext.UIDFromContext(ctx)
+		id, _ := core.FromBase58(uid.Sub)
+		requesterID := uint(id.GetLocalID())
+
+		if err := s.notificationService.NotifyTaskEvent(ctx, &service.TaskEvent{
+			Type:         entity.NotificationTypeAssigned,
+			Task:         task,
+			ActorID:      requesterID,
+			RecipientIDs: newAssigneeIDs,
+		}); err != nil {
+			return nil, err
+		}
+
+		s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, task, requesterID)
+	}
+
+	return &service.AssignTaskResponse{Message: "assign task successfully"}, nil
+}
+
+// UnassignTask unassigns an user from a specific task.
+func (s *taskService) UnassignTask(ctx context.Context, req *service.UnassignTaskRequest) (*service.UnassignTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+	userID := uint(userUID.GetLocalID())
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Assignees can always unassign themselves, others need to be editors
+	if requesterID != userID {
+		if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+			return nil, err
+		}
+	}
+
+	assignees, err := s.taskAssigneeRepo.FindUserIDsByTaskIDs(ctx, []uint{task.ID})
+	if err != nil {
+		return nil, err
+	}
+	task.AssigneeIDs = assignees[task.ID]
+
+	// Nothing changes if user is not assigned
+	if !task.IsAssignee(userID) {
+		return &service.UnassignTaskResponse{Message: "unassign task successfully"}, nil
+	}
+
+	if err := s.taskAssigneeRepo.DeleteOne(ctx, task.ID, userID); err != nil {
+		return nil, err
+	}
+
+	s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, task, requesterID)
+
+	return &service.UnassignTaskResponse{Message: "unassign task successfully"}, nil
+}
+
+// ShareTask shares a specific task with an user.
+func (s *taskService) ShareTask(ctx context.Context, req *service.ShareTaskRequest) (*service.ShareTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"user_id": err.Error()})
+	}
+	userID := uint(userUID.GetLocalID())
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionManage); err != nil {
+		return nil, err
+	}
+
+	if userID == task.UserID {
+		return nil, kiterrors.ErrBadRequest.WithDetails("task can not be shared with its owner")
+	}
+
+	// Make sure shared user is an existing user
+	users, err := s.userRepo.GetUsersByIDs(ctx, []uint{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, kiterrors.ErrNotFound.WithDetails("user not found")
+	}
+
+	if err := s.taskShareRepo.UpsertOne(ctx, &entity.TaskShare{
+		TaskID:     task.ID,
+		UserID:     userID,
+		Permission: entity.SharePermission(req.Permission),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &service.ShareTaskResponse{Message: "share task successfully"}, nil
+}
+
+// UnshareTask stops sharing a specific task with an user.
+func (s *taskService) UnshareTask(ctx context.Context, req *service.UnshareTaskRequest) (*service.UnshareTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+	userID := uint(userUID.GetLocalID())
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	// Users can always give up a share, others need to manage the task
+	if requesterID != userID {
+		if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionManage); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.taskShareRepo.DeleteOne(ctx, task.ID, userID); err != nil {
+		return nil, err
+	}
+
+	return &service.UnshareTaskResponse{Message: "unshare task successfully"}, nil
+}
+
+// ListTaskShares finds and returns users a specific task is shared with.
+func (s *taskService) ListTaskShares(ctx context.Context, req *service.ListTaskSharesRequest) (*service.ListTaskSharesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	shares, err := s.taskShareRepo.FindByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, len(shares))
+
+	for i := range shares {
+		userIDs[i] = shares[i].UserID
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, sh := range shares {
+		shares[i].User = userMap[sh.UserID]
+		shares[i].Mask()
+	}
+
+	return &service.ListTaskSharesResponse{Items: shares}, nil
+}
+
+// WatchTask makes requester follow changes of a specific task.
+func (s *taskService) WatchTask(ctx context.Context, req *service.WatchTaskRequest) (*service.WatchTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findWatchedTask(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	if err := s.taskWatcherRepo.InsertOne(ctx, task.ID, requesterID); err != nil {
+		return nil, err
+	}
+
+	return &service.WatchTaskResponse{Message: "watch task successfully"}, nil
+}
+
+// UnwatchTask makes requester stop following changes of a specific task.
+func (s *taskService) UnwatchTask(ctx context.Context, req *service.UnwatchTaskRequest) (*service.UnwatchTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findWatchedTask(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	if err := s.taskWatcherRepo.DeleteOne(ctx, task.ID, requesterID); err != nil {
+		return nil, err
+	}
+
+	return &service.UnwatchTaskResponse{Message: "unwatch task successfully"}, nil
+}
+
+// ListTaskWatchers finds and returns users following changes of a specific
+// task.
+func (s *taskService) ListTaskWatchers(ctx context.Context, req *service.ListTaskWatchersRequest) (*service.ListTaskWatchersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findWatchedTask(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs, err := s.taskWatcherRepo.FindUserIDsByTaskID(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		users[i].Mask()
+	}
+
+	return &service.ListTaskWatchersResponse{Items: users}, nil
+}
+
+// ListSubtasks finds and returns a list of subtasks of a specific task.
+func (s *taskService) ListSubtasks(ctx context.Context, req *service.ListSubtasksRequest) (*service.ListSubtasksResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	filter := &entity.Filter{ParentID: &task.ID}
+
+	// Archived subtasks are left out, unless parent is archived itself
+	if task.Status != entity.StatusArchived {
+		archived := false
+		filter.Archived = &archived
+	}
+
+	if err := s.authorizer.ScopeTasks(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	tasks, err := s.taskRepo.FindRangeByCriteria(ctx, filter, nil, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enrichTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &service.ListSubtasksResponse{
+		Items:   tasks,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// MoveTask moves a specific task under another parent task, or to top level if
+// no parent is given.
+func (s *taskService) MoveTask(ctx context.Context, req *service.MoveTaskRequest) (*service.MoveTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	previousParentID := task.ParentID
+	task.ParentID = nil
+
+	if req.ParentID != nil && *req.ParentID != "" {
+		parent, err := s.findParentTask(ctx, *req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.authorizer.AuthorizeTask(ctx, parent, entity.ActionEdit); err != nil {
+			return nil, err
+		}
+
+		// Guard against cycles: new parent must not be the task itself or one
+		// of its descendants
+		if err := s.checkNotDescendant(ctx, parent, task.ID); err != nil {
+			return nil, err
+		}
+
+		task.ParentID = &parent.ID
+	}
+
+	if err := s.taskRepo.UpdateOne(ctx, task); err != nil {
+		return nil, err
+	}
+
+	if !equalIDs(previousParentID, task.ParentID) {
+		uid := kitcontext.UIDFromContext(ctx)
+		id, _ := core.FromBase58(uid.Sub)
+
+		s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, task, uint(id.GetLocalID()))
+	}
+
+	return &service.MoveTaskResponse{Message: "move task successfully"}, nil
+}
+
+// AddTaskDependency marks a specific task as blocked by another task.
+func (s *taskService) AddTaskDependency(ctx context.Context, req *service.AddTaskDependencyRequest) (*service.AddTaskDependencyResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	blockerUID, err := core.FromBase58(req.BlockerID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("blocker task not found")
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	blocker, err := s.taskRepo.FindOne(ctx, uint(blockerUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("blocker task not found")
+		}
+
+		return nil, err
+	}
+
+	if blocker.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound.WithDetails("blocker task not found")
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, blocker, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	// Guard against cycles: task must not already block the blocker, directly
+	// or transitively
+	if err := s.checkNotBlocking(ctx, task.ID, blocker.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.taskDependencyRepo.InsertOne(ctx, &entity.TaskDependency{
+		TaskID:    task.ID,
+		BlockerID: blocker.ID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &service.AddTaskDependencyResponse{Message: "add task dependency successfully"}, nil
+}
+
+// RemoveTaskDependency removes a blocker from a specific task.
+func (s *taskService) RemoveTaskDependency(ctx context.Context, req *service.RemoveTaskDependencyRequest) (*service.RemoveTaskDependencyResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	blockerUID, err := core.FromBase58(req.BlockerID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	if err := s.taskDependencyRepo.DeleteOne(ctx, task.ID, uint(blockerUID.GetLocalID())); err != nil {
+		return nil, err
+	}
+
+	return &service.RemoveTaskDependencyResponse{Message: "remove task dependency successfully"}, nil
+}
+
+// ListProjectTasks finds and returns a list of tasks of a specific project.
+func (s *taskService) ListProjectTasks(ctx context.Context, req *service.ListProjectTasksRequest) (*service.ListProjectTasksResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	project, err := s.findProject(ctx, req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeProject(ctx, project, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	filter := &entity.Filter{ProjectID: &project.ID}
+
+	// Archived tasks are left out, unless project is archived along with them
+	if project.Status != entity.ProjectStatusArchived {
+		archived := false
+		filter.Archived = &archived
+	}
+
+	tasks, err := s.taskRepo.FindRangeByCriteria(ctx, filter, nil, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enrichTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &service.ListProjectTasksResponse{
+		Items:   tasks,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// ListTaskActivities finds and returns history of changes of a specific task.
+func (s *taskService) ListTaskActivities(ctx context.Context, req *service.ListTaskActivitiesRequest) (*service.ListTaskActivitiesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	activities, err := s.activityRepo.FindRangeByTaskID(ctx, task.ID, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, len(activities))
+
+	for i := range activities {
+		userIDs[i] = activities[i].UserID
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, uniqueIDs(userIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, a := range activities {
+		activities[i].Actor = userMap[a.UserID]
+		activities[i].Mask()
+	}
+
+	return &service.ListTaskActivitiesResponse{
+		Items:   activities,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// ArchiveTask archives a specific task, hiding it from lists of tasks.
+func (s *taskService) ArchiveTask(ctx context.Context, req *service.ArchiveTaskRequest) (*service.ArchiveTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	if task.Status == entity.StatusArchived {
+		return nil, kiterrors.ErrBadRequest.WithDetails("task has already been archived")
+	}
+
+	if err := s.taskRepo.ArchiveOne(ctx, task.ID); err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	if err := s.activityRepo.InsertMany(ctx, []entity.Activity{
+		taskActivity(task.ID, requesterID, entity.ActivityActionArchived),
+	}); err != nil {
+		return nil, err
+	}
+
+	// Integrations get the task as it is once archived
+	archived, err := s.taskRepo.FindOne(ctx, task.ID)
+	if err != nil {
+		logrus.Errorf("Can not dispatch %s webhooks of task %d, error: %s", entity.WebhookEventTaskUpdated, task.ID, err.Error())
+	} else {
+		s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, archived, requesterID)
+	}
+
+	return &service.ArchiveTaskResponse{Message: "archive task successfully"}, nil
+}
+
+// UnarchiveTask brings a specific archived task back to its previous status.
+func (s *taskService) UnarchiveTask(ctx context.Context, req *service.UnarchiveTaskRequest) (*service.UnarchiveTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	cUID, err := core.FromBase58(req.ID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	if task.Status != entity.StatusArchived {
+		return nil, kiterrors.ErrBadRequest.WithDetails("task is not archived")
+	}
+
+	// Tasks of an archived project stay archived along with it
+	if task.ProjectID != nil {
+		project, err := s.projectRepo.FindOne(ctx, *task.ProjectID)
+		if err != nil && err != kiterrors.ErrRepoEntityNotFound {
+			return nil, err
+		}
+
+		if project != nil && project.Status == entity.ProjectStatusArchived {
+			return nil, kiterrors.ErrForbidden.WithDetails("project of task is archived")
+		}
+	}
+
+	status, category, err := s.restoredStatus(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Status = status
+	task.StatusCategory = category
+	task.PreviousStatus = nil
+
+	if err := s.taskRepo.UpdateOne(ctx, task); err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	if err := s.activityRepo.InsertMany(ctx, []entity.Activity{
+		taskActivity(task.ID, requesterID, entity.ActivityActionUnarchived),
+	}); err != nil {
+		return nil, err
+	}
+
+	s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, task, requesterID)
+
+	return &service.UnarchiveTaskResponse{Message: "unarchive task successfully"}, nil
+}
+
+// ListTrash finds and returns a list of deleted tasks of requester.
+func (s *taskService) ListTrash(ctx context.Context, req *service.ListTrashRequest) (*service.ListTrashResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	tasks, err := s.taskRepo.FindRangeByCriteria(ctx, &entity.Filter{UserID: &requesterID, Trashed: true}, nil, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enrichTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return &service.ListTrashResponse{
+		Items:   tasks,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// RestoreTask restores a specific deleted task to its previous status.
+func (s *taskService) RestoreTask(ctx context.Context, req *service.RestoreTaskRequest) (*service.RestoreTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTrashedTask(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionDelete); err != nil {
+		return nil, err
+	}
+
+	// Task of a deleted project goes away with the project
+	if task.ProjectID != nil {
+		project, err := s.projectRepo.FindOne(ctx, *task.ProjectID)
+		if err != nil && err != kiterrors.ErrRepoEntityNotFound {
+			return nil, err
+		}
+
+		if project == nil || project.Status == entity.ProjectStatusDeleted {
+			return nil, kiterrors.ErrForbidden.WithDetails("project of task has been deleted")
+		}
+	}
+
+	status, category, err := s.restoredStatus(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Status = status
+	task.StatusCategory = category
+	task.PreviousStatus = nil
+	task.DeletedAt = nil
+
+	if err := s.taskRepo.UpdateOne(ctx, task); err != nil {
+		return nil, err
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	if err := s.activityRepo.InsertMany(ctx, []entity.Activity{
+		taskActivity(task.ID, requesterID, entity.ActivityActionRestored),
+	}); err != nil {
+		return nil, err
+	}
+
+	s.dispatchWebhooks(ctx, entity.WebhookEventTaskUpdated, task, requesterID)
+
+	return &service.RestoreTaskResponse{Message: "restore task successfully"}, nil
+}
+
+// PurgeTask permanently deletes a specific deleted task.
+func (s *taskService) PurgeTask(ctx context.Context, req *service.PurgeTaskRequest) (*service.PurgeTaskResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	task, err := s.findTrashedTask(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionDelete); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachmentRepo.FindByTaskIDs(ctx, []uint{task.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.taskRepo.Purge(ctx, task.ID); err != nil {
+		return nil, err
+	}
+
+	// Files are removed once their metadata is gone, a failure only leaves an
+	// unreachable file behind
+	for _, a := range attachments {
+		if err := s.blobStorage.Delete(ctx, a.StorageKey); err != nil {
+			logrus.Errorf("Can not delete file of attachment %d, error: %s", a.ID, err.Error())
+		}
+	}
+
+	// Integrations get the task as it was in trash
+	s.dispatchWebhooks(ctx, entity.WebhookEventTaskPurged, task, requesterIDFromContext(ctx))
+
+	return &service.PurgeTaskResponse{Message: "purge task successfully"}, nil
+}
+
+// checkNotBlocking fails if task with given id blocks blocker, directly or
+// transitively, or is the blocker itself. It walks up the blockers of blocker
+// level by level.
+func (s *taskService) checkNotBlocking(ctx context.Context, id uint, blockerID uint) error {
+	visited := map[uint]bool{blockerID: true}
+	level := []uint{blockerID}
+
+	for len(level) > 0 {
+		for _, current := range level {
+			if current == id {
+				return kiterrors.ErrBadRequest.WithDetails("dependency would create a cycle")
+			}
+		}
+
+		blockerMap, err := s.taskDependencyRepo.FindBlockerIDsByTaskIDs(ctx, level)
+		if err != nil {
+			return err
+		}
+
+		next := make([]uint, 0)
+		for _, ids := range blockerMap {
+			for _, blocker := range ids {
+				if !visited[blocker] {
+					visited[blocker] = true
+					next = append(next, blocker)
+				}
+			}
+		}
+		level = next
+	}
+
+	return nil
+}
+
+// checkNotBlocked fails if task still has blockers which are not closed.
+func (s *taskService) checkNotBlocked(ctx context.Context, id uint) error {
+	blockerIDs, err := s.taskDependencyRepo.FindUnfinishedBlockerIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(blockerIDs) == 0 {
+		return nil
+	}
+
+	blockedBy := make([]string, len(blockerIDs))
+
+	for i := range blockerIDs {
+		uid := core.NewUID(uint32(blockerIDs[i]), entity.MaskTypeTask, 1)
+		blockedBy[i] = uid.String()
+	}
+
+	return kiterrors.ErrForbidden.WithDetails(map[string]interface{}{
+		"message":    "task is blocked by unfinished tasks",
+		"blocked_by": blockedBy,
+	})
+}
+
+// transitStatus checks that task can move to status, by its workflow or by
+// built-in transitions if it has no workflow, and returns category of status.
+func (s *taskService) transitStatus(ctx context.Context, task *entity.Task, status entity.Status) (entity.StatusCategory, error) {
+	if task.WorkflowID == nil {
+		if !task.Status.CanTransitionTo(status) {
+			return "", kiterrors.ErrBadRequest.WithDetails(domain.NewStatusTransitionDetails(task.Status, status, task.Status.NextStatuses()))
+		}
+
+		return status.Category(), nil
+	}
+
+	workflow, err := s.workflowRepo.FindOne(ctx, *task.WorkflowID)
+	if err != nil {
+		return "", err
+	}
+
+	next := workflow.FindStatus(status)
+	if next == nil || !workflow.CanTransition(task.Status, status) {
+		return "", kiterrors.ErrBadRequest.WithDetails(domain.NewStatusTransitionDetails(task.Status, status, workflow.NextStatuses(task.Status)))
+	}
+
+	return next.Category, nil
+}
+
+// findWorkflow fetches a workflow by its masked id, making sure requester can
+// use it.
+func (s *taskService) findWorkflow(ctx context.Context, workflowID string) (*entity.Workflow, error) {
+	cUID, err := core.FromBase58(workflowID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("workflow not found")
+	}
+
+	workflow, err := s.workflowRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("workflow not found")
+		}
+
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeWorkflow(ctx, workflow, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// findProject fetches a project by its masked id. Deleted project is seen as
+// not existing.
+func (s *taskService) findProject(ctx context.Context, projectID string) (*entity.Project, error) {
+	cUID, err := core.FromBase58(projectID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("project not found")
+	}
+
+	project, err := s.projectRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("project not found")
+		}
+
+		return nil, err
+	}
+
+	if project.Status == entity.ProjectStatusDeleted {
+		return nil, kiterrors.ErrNotFound.WithDetails("project not found")
+	}
+
+	return project, nil
+}
+
+// findParentTask fetches a task by its masked id to be used as a parent.
+func (s *taskService) findParentTask(ctx context.Context, parentID string) (*entity.Task, error) {
+	cUID, err := core.FromBase58(parentID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("parent task not found")
+	}
+
+	parent, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("parent task not found")
+		}
+
+		return nil, err
+	}
+
+	if parent.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound.WithDetails("parent task not found")
+	}
+
+	return parent, nil
+}
+
+// findWatchedTask fetches a task by its masked id for watching. Anyone who
+// can view a task can watch it.
+func (s *taskService) findWatchedTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status == entity.StatusDeleted {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	if err := s.authorizer.AuthorizeTask(ctx, task, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// findTrashedTask fetches a deleted task by its masked id. Only tasks in
+// trash can be restored or purged.
+func (s *taskService) findTrashedTask(ctx context.Context, taskID string) (*entity.Task, error) {
+	cUID, err := core.FromBase58(taskID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	task, err := s.taskRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if task.Status != entity.StatusDeleted {
+		return nil, kiterrors.ErrBadRequest.WithDetails("task is not in trash")
+	}
+
+	return task, nil
+}
+
+// dispatchWebhooks queues deliveries of an event on task to webhooks of the
+// tenant. The change is already saved by then, so failing to queue them is
+// logged rather than failing the request.
+func (s *taskService) dispatchWebhooks(ctx context.Context, event entity.WebhookEvent, task *entity.Task, actorID uint) {
+	if err := s.webhookService.DispatchTaskEvent(ctx, event, task, actorID); err != nil {
+		logrus.Errorf("Can not dispatch %s webhooks of task %d, error: %s", event, task.ID, err.Error())
+	}
+}
+
+// restoredStatus returns status which a deleted or archived task goes back
+// to, with its category. Task falls back to the initial status if its
+// previous status is unknown or no longer exists in its workflow. Task whose
+// workflow no longer exists leaves it and goes back to built-in statuses.
+func (s *taskService) restoredStatus(ctx context.Context, task *entity.Task) (entity.Status, entity.StatusCategory, error) {
+	status := entity.StatusTodo
+	if task.PreviousStatus != nil && *task.PreviousStatus != task.Status {
+		status = *task.PreviousStatus
+	}
+
+	// A task deleted while archived goes back to archive
+	if task.WorkflowID == nil || status == entity.StatusArchived {
+		return status, status.Category(), nil
+	}
+
+	workflow, err := s.workflowRepo.FindOne(ctx, *task.WorkflowID)
+	if err != nil {
+		if err != kiterrors.ErrRepoEntityNotFound {
+			return "", "", err
+		}
+
+		task.WorkflowID = nil
+		if status.Category() == "" {
+			status = entity.StatusTodo
+		}
+
+		return status, status.Category(), nil
+	}
+
+	if previous := workflow.FindStatus(status); previous != nil {
+		return previous.Key, previous.Category, nil
+	}
+
+	if initial := workflow.InitialStatus(); initial != nil {
+		return initial.Key, initial.Category, nil
+	}
+
+	return status, status.Category(), nil
+}
+
+// checkNotDescendant walks up the ancestors of task and fails if task with
+// given id is one of them, or is the task itself.
+func (s *taskService) checkNotDescendant(ctx context.Context, task *entity.Task, id uint) error {
+	visited := make(map[uint]bool)
+
+	for current := task; ; {
+		if current.ID == id {
+			return kiterrors.ErrBadRequest.WithDetails("cannot move a task under itself or its subtasks")
+		}
+
+		// Stop on broken hierarchy instead of looping forever
+		if current.ParentID == nil || visited[current.ID] {
+			return nil
+		}
+		visited[current.ID] = true
+
+		next, err := s.taskRepo.FindOne(ctx, *current.ParentID)
+		if err != nil {
+			if err == kiterrors.ErrRepoEntityNotFound {
+				return nil
+			}
+
+			return err
+		}
+		current = next
+	}
+}
+
+// enrichTasks fills extra infos of tasks (owner, assignees, labels, subtasks
+// and checklist progress, overdue) and masks them. Related data is fetched in
+// batch for all tasks.
+func (s *taskService) enrichTasks(ctx context.Context, tasks []entity.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]uint, len(tasks))
+
+	for i := range tasks {
+		taskIDs[i] = tasks[i].ID
+	}
+
+	assigneeMap, err := s.taskAssigneeRepo.FindUserIDsByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	labelMap, err := s.labelRepo.FindByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	subtaskMap, err := s.taskRepo.CountSubtasks(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	checklistMap, err := s.checklistItemRepo.CountByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+
+	// Get extra infos: User, Assignees in one call
+	userIDs := make([]uint, 0, len(tasks))
+
+	for i := range tasks {
+		userIDs = append(userIDs, tasks[i].UserID)
+		userIDs = append(userIDs, assigneeMap[tasks[i].ID]...)
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, uniqueIDs(userIDs))
+	if err != nil {
+		return err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	now := time.Now()
+	for i, t := range tasks {
+		tasks[i].User = userMap[t.UserID]
+		tasks[i].AssigneeIDs = assigneeMap[t.ID]
+		tasks[i].Assignees = make([]entity.SimpleUser, 0, len(tasks[i].AssigneeIDs))
+		for _, id := range tasks[i].AssigneeIDs {
+			if u, ok := userMap[id]; ok {
+				tasks[i].Assignees = append(tasks[i].Assignees, *u)
+			}
+		}
+		tasks[i].Labels = labelMap[t.ID]
+		subtasks := subtaskMap[t.ID]
+		tasks[i].Subtasks = &subtasks
+		checklist := checklistMap[t.ID]
+		tasks[i].Checklist = &checklist
+		tasks[i].Overdue = tasks[i].IsOverdue(now)
+		tasks[i].Mask()
+	}
+
+	return nil
+}
+
+// setRecurrence sets recurrence rule of a task, normalized, and schedules its
+// next occurrence.
+func setRecurrence(task *entity.Task, text string) error {
+	rule, err := entity.ParseRecurrenceRule(text)
+	if err != nil {
+		return kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"recurrence": err.Error()})
+	}
+
+	recurrence := rule.String()
+	task.Recurrence = &recurrence
+
+	return kiterrors.WithStack(task.ScheduleRecurrence(time.Now()))
+}
+
+// validateSchedule checks that due date of a task is not before its start date.
+func validateSchedule(startAt, dueAt *time.Time) error {
+	if startAt == nil || dueAt == nil {
+		return nil
+	}
+
+	if dueAt.Before(*startAt) {
+		return kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"due_at": "due_at must not be before start_at"})
+	}
+
+	return nil
+}
+
+// decodeUIDs decodes list of masked ids to list of local ids, skipping
+// duplicates.
+func decodeUIDs(uids []string) ([]uint, error) {
+	ids := make([]uint, 0, len(uids))
+	seen := make(map[uint]bool, len(uids))
+
+	for _, s := range uids {
+		uid, err := core.FromBase58(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q", s)
+		}
+
+		id := uint(uid.GetLocalID())
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// uniqueIDs returns ids without duplicates, keeping their order.
+func uniqueIDs(ids []uint) []uint {
+	result := make([]uint, 0, len(ids))
+	seen := make(map[uint]bool, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+
+	return result
+}
+
+// equalIDs reports whether two optional ids are the same.
+func equalIDs(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
 }
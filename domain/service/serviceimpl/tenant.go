@@ -0,0 +1,149 @@
+package serviceimpl
+
+import (
+	"context"
+
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/rpc"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+type tenantService struct {
+	roleBindingRepo store.RoleBindingRepo
+	authorizer      service.Authorizer
+	userRepo        rpc.UserRepo
+	validator       validator.Validator
+}
+
+// NewTenantService creates and returns a new instance of TenantService.
+func NewTenantService(
+	roleBindingRepo store.RoleBindingRepo,
+	authorizer service.Authorizer,
+	userRepo rpc.UserRepo,
+	validator validator.Validator,
+) service.TenantService {
+	return &tenantService{
+		roleBindingRepo: roleBindingRepo,
+		authorizer:      authorizer,
+		userRepo:        userRepo,
+		validator:       validator,
+	}
+}
+
+// AddTenantMember grants a role on whole tenant to an user.
+func (s *tenantService) AddTenantMember(ctx context.Context, req *service.AddTenantMemberRequest) (*service.AddTenantMemberResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if err := s.authorizer.AuthorizeTenant(ctx, entity.ActionManage); err != nil {
+		return nil, err
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"user_id": err.Error()})
+	}
+	userID := uint(userUID.GetLocalID())
+
+	// Make sure member is an existing user
+	users, err := s.userRepo.GetUsersByIDs(ctx, []uint{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, kiterrors.ErrNotFound.WithDetails("user not found")
+	}
+
+	if err := s.roleBindingRepo.UpsertOne(ctx, &entity.RoleBinding{
+		UserID: userID,
+		Role:   entity.Role(req.Role),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &service.AddTenantMemberResponse{Message: "add tenant member successfully"}, nil
+}
+
+// RemoveTenantMember revokes role of an user on whole tenant.
+func (s *tenantService) RemoveTenantMember(ctx context.Context, req *service.RemoveTenantMemberRequest) (*service.RemoveTenantMemberResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	userUID, err := core.FromBase58(req.UserID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+	userID := uint(userUID.GetLocalID())
+
+	// Members can always leave the tenant, others need to manage it
+	if userID != requesterIDFromContext(ctx) {
+		if err := s.authorizer.AuthorizeTenant(ctx, entity.ActionManage); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.roleBindingRepo.DeleteOneOnTenant(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &service.RemoveTenantMemberResponse{Message: "remove tenant member successfully"}, nil
+}
+
+// ListTenantMembers finds and returns a list of users having a role on whole
+// tenant.
+func (s *tenantService) ListTenantMembers(ctx context.Context, req *service.ListTenantMembersRequest) (*service.ListTenantMembersResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if err := s.authorizer.AuthorizeTenant(ctx, entity.ActionView); err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	members, err := s.roleBindingRepo.FindRangeOnTenant(ctx, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, len(members))
+
+	for i := range members {
+		userIDs[i] = members[i].UserID
+	}
+
+	users, err := s.userRepo.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// For speed up mapping data
+	userMap := make(map[uint]*entity.SimpleUser)
+
+	for i, u := range users {
+		userMap[u.ID] = &users[i]
+	}
+
+	for i, m := range members {
+		members[i].User = userMap[m.UserID]
+		members[i].Mask()
+	}
+
+	return &service.ListTenantMembersResponse{
+		Items:   members,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
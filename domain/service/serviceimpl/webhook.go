@@ -0,0 +1,606 @@
+package serviceimpl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/repo/webhook"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+const (
+	// webhookSecretSize is number of random bytes of a generated secret.
+	webhookSecretSize = 32
+
+	// webhookBatchSize is the number of deliveries attempted in one round.
+	// Attempts are made one by one, so a round must fit in the lease even if
+	// every endpoint times out.
+	webhookBatchSize = 20
+
+	// webhookLease is how long a claimed delivery is kept from being claimed
+	// again, giving time to attempt it before it is retried.
+	webhookLease = 5 * time.Minute
+
+	// webhookMaxAttempts is the number of attempts of a delivery before it is
+	// given up.
+	webhookMaxAttempts = 8
+
+	// webhookRetryBaseDelay is the delay before the first retry of a delivery,
+	// it doubles on each retry up to webhookRetryMaxDelay.
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+
+	// webhookDisableAfterFailures is the number of failed attempts in a row,
+	// across deliveries, after which a webhook is disabled.
+	webhookDisableAfterFailures = 20
+)
+
+// Headers sent along with every payload posted to a webhook.
+const (
+	webhookEventHeader     = "X-Webhook-Event"
+	webhookDeliveryHeader  = "X-Webhook-Delivery"
+	webhookSignatureHeader = "X-Webhook-Signature"
+	webhookUserAgent       = "cp-task-webhook"
+)
+
+// webhookPayload is the body posted to webhooks for an event on a task.
+type webhookPayload struct {
+	Event     entity.WebhookEvent `json:"event"`
+	CreatedAt time.Time           `json:"created_at"`
+	ActorID   *core.UID           `json:"actor_id"`
+	Task      *webhookTask        `json:"task"`
+	// WatcherIDs are users following the task, the audience of the event
+	WatcherIDs []core.UID `json:"watcher_ids"`
+}
+
+// webhookTask is the task sent in webhook payloads. It is kept apart from
+// entity.Task, so only masked ids and fields meant for receivers go out.
+type webhookTask struct {
+	ID             core.UID              `json:"id"`
+	OwnerID        core.UID              `json:"owner_id"`
+	ParentID       *core.UID             `json:"parent_id"`
+	ProjectID      *core.UID             `json:"project_id"`
+	WorkflowID     *core.UID             `json:"workflow_id"`
+	Title          string                `json:"title"`
+	Description    string                `json:"description"`
+	Status         entity.Status         `json:"status"`
+	StatusCategory entity.StatusCategory `json:"status_category"`
+	Priority       entity.Priority       `json:"priority"`
+	StartAt        *time.Time            `json:"start_at"`
+	DueAt          *time.Time            `json:"due_at"`
+	Recurrence     *string               `json:"recurrence"`
+	DeletedAt      *time.Time            `json:"deleted_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// newWebhookTask builds the task sent in webhook payloads from task.
+func newWebhookTask(task *entity.Task) *webhookTask {
+	result := &webhookTask{
+		ID:             core.NewUID(uint32(task.ID), entity.MaskTypeTask, 1),
+		OwnerID:        core.NewUID(uint32(task.UserID), entity.MaskTypeUser, 1),
+		Title:          task.Title,
+		Description:    task.Description,
+		Status:         task.Status,
+		StatusCategory: task.StatusCategory,
+		Priority:       task.Priority,
+		StartAt:        task.StartAt,
+		DueAt:          task.DueAt,
+		Recurrence:     task.Recurrence,
+		DeletedAt:      task.DeletedAt,
+		CreatedAt:      task.CreatedAt,
+		UpdatedAt:      task.UpdatedAt,
+	}
+
+	if task.ParentID != nil {
+		parentUID := core.NewUID(uint32(*task.ParentID), entity.MaskTypeTask, 1)
+		result.ParentID = &parentUID
+	}
+
+	if task.ProjectID != nil {
+		projectUID := core.NewUID(uint32(*task.ProjectID), entity.MaskTypeProject, 1)
+		result.ProjectID = &projectUID
+	}
+
+	if task.WorkflowID != nil {
+		workflowUID := core.NewUID(uint32(*task.WorkflowID), entity.MaskTypeWorkflow, 1)
+		result.WorkflowID = &workflowUID
+	}
+
+	return result
+}
+
+type webhookService struct {
+	webhookRepo     store.WebhookRepo
+	taskWatcherRepo store.TaskWatcherRepo
+	sender          webhook.Sender
+	authorizer      service.Authorizer
+	validator       validator.Validator
+}
+
+// NewWebhookService creates and returns a new instance of WebhookService.
+func NewWebhookService(
+	webhookRepo store.WebhookRepo,
+	taskWatcherRepo store.TaskWatcherRepo,
+	sender webhook.Sender,
+	authorizer service.Authorizer,
+	validator validator.Validator,
+) service.WebhookService {
+	return &webhookService{
+		webhookRepo:     webhookRepo,
+		taskWatcherRepo: taskWatcherRepo,
+		sender:          sender,
+		authorizer:      authorizer,
+		validator:       validator,
+	}
+}
+
+// CreateWebhook subscribes an endpoint to changes on tasks of the tenant.
+func (s *webhookService) CreateWebhook(ctx context.Context, req *service.CreateWebhookRequest) (*service.CreateWebhookResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if err := validateWebhookURL(req.URL); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeTenant(ctx, entity.ActionManage); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	hook := &entity.Webhook{
+		UserID: requesterID,
+		URL:    req.URL,
+		Secret: secret,
+		Events: webhookEvents(req.Events),
+		Status: entity.WebhookStatusActive,
+	}
+	if err := s.webhookRepo.InsertOne(ctx, hook); err != nil {
+		return nil, err
+	}
+	hook.Mask()
+
+	return &service.CreateWebhookResponse{
+		Message: "create webhook successfully",
+		ID:      hook.FakeID,
+		Secret:  secret,
+	}, nil
+}
+
+// ListWebhooks finds and returns webhooks of the tenant, newest first.
+func (s *webhookService) ListWebhooks(ctx context.Context, req *service.ListWebhooksRequest) (*service.ListWebhooksResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	if err := s.authorizer.AuthorizeTenant(ctx, entity.ActionManage); err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	webhooks, err := s.webhookRepo.FindRange(ctx, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range webhooks {
+		webhooks[i].Mask()
+	}
+
+	return &service.ListWebhooksResponse{
+		Items:   webhooks,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// UpdateWebhook updates a specific webhook, enabling it again if asked.
+func (s *webhookService) UpdateWebhook(ctx context.Context, req *service.UpdateWebhookRequest) (*service.UpdateWebhookResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	hook, err := s.findWebhook(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+		hook.URL = *req.URL
+	}
+
+	if req.Secret != nil {
+		hook.Secret = *req.Secret
+	}
+
+	if req.Events != nil {
+		hook.Events = webhookEvents(*req.Events)
+	}
+
+	if req.Active != nil {
+		switch {
+		case *req.Active && hook.Status != entity.WebhookStatusActive:
+			// Enabled webhook gets a fresh start, pending deliveries resume
+			hook.Status = entity.WebhookStatusActive
+			hook.FailureCount = 0
+			hook.DisabledAt = nil
+		case !*req.Active && hook.Status != entity.WebhookStatusDisabled:
+			now := time.Now()
+			hook.Status = entity.WebhookStatusDisabled
+			hook.DisabledAt = &now
+		}
+	}
+
+	if err := s.webhookRepo.UpdateOne(ctx, hook); err != nil {
+		return nil, err
+	}
+
+	return &service.UpdateWebhookResponse{Message: "update webhook successfully"}, nil
+}
+
+// DeleteWebhook deletes a specific webhook along with its deliveries.
+func (s *webhookService) DeleteWebhook(ctx context.Context, req *service.DeleteWebhookRequest) (*service.DeleteWebhookResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	hook, err := s.findWebhook(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.DeleteOne(ctx, hook.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteWebhookResponse{Message: "delete webhook successfully"}, nil
+}
+
+// ListWebhookDeliveries finds and returns deliveries of a specific webhook,
+// newest first.
+func (s *webhookService) ListWebhookDeliveries(ctx context.Context, req *service.ListWebhookDeliveriesRequest) (*service.ListWebhookDeliveriesResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	hook, err := s.findWebhook(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	deliveries, err := s.webhookRepo.FindDeliveries(ctx, hook.ID, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range deliveries {
+		deliveries[i].Mask()
+	}
+
+	return &service.ListWebhookDeliveriesResponse{
+		Items:   deliveries,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// ListWebhookDeliveryAttempts finds and returns attempts of a specific
+// delivery, in order.
+func (s *webhookService) ListWebhookDeliveryAttempts(ctx context.Context, req *service.ListWebhookDeliveryAttemptsRequest) (*service.ListWebhookDeliveryAttemptsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	hook, err := s.findWebhook(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cUID, err := core.FromBase58(req.DeliveryID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("delivery not found")
+	}
+
+	delivery, err := s.webhookRepo.FindDelivery(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("delivery not found")
+		}
+
+		return nil, err
+	}
+
+	if delivery.WebhookID != hook.ID {
+		return nil, kiterrors.ErrNotFound.WithDetails("delivery not found")
+	}
+
+	attempts, err := s.webhookRepo.FindAttempts(ctx, delivery.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.ListWebhookDeliveryAttemptsResponse{Items: attempts}, nil
+}
+
+// DispatchTaskEvent queues a delivery of an event on a task to every active
+// webhook of the tenant subscribing to it.
+func (s *webhookService) DispatchTaskEvent(ctx context.Context, event entity.WebhookEvent, task *entity.Task, actorID uint) error {
+	webhooks, err := s.webhookRepo.FindActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	subscribed := make([]entity.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		if w.Subscribes(event) {
+			subscribed = append(subscribed, w)
+		}
+	}
+
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	watcherIDs, err := s.taskWatcherRepo.FindUserIDsByTaskID(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+
+	// Watchers who lost access to the task are not disclosed
+	watcherIDs, err = s.authorizer.FilterTaskViewers(ctx, task, watcherIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	// Payload is built once, so every webhook receives the same snapshot
+	payload := webhookPayload{
+		Event:      event,
+		CreatedAt:  now,
+		Task:       newWebhookTask(task),
+		WatcherIDs: make([]core.UID, len(watcherIDs)),
+	}
+
+	if actorID != 0 {
+		actorUID := core.NewUID(uint32(actorID), entity.MaskTypeUser, 1)
+		payload.ActorID = &actorUID
+	}
+
+	for i, userID := range watcherIDs {
+		payload.WatcherIDs[i] = core.NewUID(uint32(userID), entity.MaskTypeUser, 1)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return kiterrors.WithStack(err)
+	}
+
+	deliveries := make([]entity.WebhookDelivery, len(subscribed))
+	for i, w := range subscribed {
+		deliveries[i] = entity.WebhookDelivery{
+			WebhookID:     w.ID,
+			Event:         event,
+			Payload:       string(body),
+			Status:        entity.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+		}
+	}
+
+	return s.webhookRepo.InsertDeliveries(ctx, deliveries)
+}
+
+// DeliverDueWebhooks attempts deliveries of all tenants which are due at the
+// given time.
+func (s *webhookService) DeliverDueWebhooks(ctx context.Context, now time.Time) (int, error) {
+	attempted := 0
+
+	// Webhooks disabled during this run, their remaining deliveries wait
+	// until they are enabled again
+	disabled := make(map[uint]bool)
+
+	for ctx.Err() == nil {
+		deliveries, err := s.webhookRepo.ClaimDueDeliveries(ctx, now, webhookLease, webhookBatchSize)
+		if err != nil {
+			return attempted, err
+		}
+
+		if len(deliveries) == 0 {
+			break
+		}
+
+		for i := range deliveries {
+			if disabled[deliveries[i].WebhookID] {
+				continue
+			}
+
+			off, err := s.attemptDelivery(ctx, &deliveries[i], now)
+			if err != nil {
+				return attempted, err
+			}
+			attempted++
+
+			if off {
+				disabled[deliveries[i].WebhookID] = true
+			}
+		}
+	}
+
+	return attempted, nil
+}
+
+// attemptDelivery posts a claimed delivery to its webhook once and records
+// the outcome. A failed delivery is scheduled for a retry with exponential
+// backoff until attempts run out. It reports whether the webhook was disabled
+// because of this attempt.
+func (s *webhookService) attemptDelivery(ctx context.Context, delivery *entity.WebhookDelivery, now time.Time) (bool, error) {
+	delivery.Mask()
+	body := []byte(delivery.Payload)
+
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/json")
+	headers.Set("User-Agent", webhookUserAgent)
+	headers.Set(webhookEventHeader, string(delivery.Event))
+	headers.Set(webhookDeliveryHeader, delivery.FakeID.String())
+	headers.Set(webhookSignatureHeader, entity.SignWebhookPayload(delivery.Webhook.Secret, body))
+
+	start := time.Now()
+	resp, err := s.sender.Send(ctx, &webhook.Request{
+		URL:     delivery.Webhook.URL,
+		Headers: headers,
+		Body:    body,
+	})
+
+	attempt := &entity.WebhookAttempt{
+		DeliveryID: delivery.ID,
+		Attempt:    delivery.Attempts + 1,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		msg := err.Error()
+		attempt.Error = &msg
+	} else {
+		attempt.ResponseStatus = &resp.StatusCode
+		attempt.ResponseBody = resp.Body
+	}
+
+	delivery.Attempts = attempt.Attempt
+	delivery.ResponseStatus = attempt.ResponseStatus
+	delivery.LastError = attempt.Error
+
+	switch {
+	case attempt.Succeeded():
+		deliveredAt := time.Now()
+		delivery.Status = entity.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &deliveredAt
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= webhookMaxAttempts:
+		delivery.Status = entity.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(webhookRetryDelay(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+	}
+
+	if err := s.webhookRepo.RecordAttempt(ctx, delivery, attempt); err != nil {
+		return false, err
+	}
+
+	if attempt.Succeeded() {
+		return false, s.webhookRepo.RecordSuccess(ctx, delivery.WebhookID)
+	}
+
+	return s.webhookRepo.RecordFailure(ctx, delivery.WebhookID, webhookDisableAfterFailures, now)
+}
+
+// findWebhook fetches a webhook of the tenant by its masked id, making sure
+// requester can manage webhooks.
+func (s *webhookService) findWebhook(ctx context.Context, webhookID string) (*entity.Webhook, error) {
+	if err := s.authorizer.AuthorizeTenant(ctx, entity.ActionManage); err != nil {
+		return nil, err
+	}
+
+	cUID, err := core.FromBase58(webhookID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("webhook not found")
+	}
+
+	hook, err := s.webhookRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("webhook not found")
+		}
+
+		return nil, err
+	}
+
+	return hook, nil
+}
+
+// webhookRetryDelay returns how long to wait before retrying a delivery which
+// failed the given number of attempts.
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= webhookRetryMaxDelay {
+			return webhookRetryMaxDelay
+		}
+	}
+
+	return delay
+}
+
+// validateWebhookURL fails if url is not an absolute http or https url.
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"url": "url must be an absolute http or https url"})
+	}
+
+	return nil
+}
+
+// webhookEvents converts names of events, dropping duplicates.
+func webhookEvents(names []string) entity.WebhookEvents {
+	events := make(entity.WebhookEvents, 0, len(names))
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			events = append(events, entity.WebhookEvent(name))
+		}
+	}
+
+	return events
+}
+
+// newWebhookSecret generates a random secret for a webhook.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", kiterrors.WithStack(err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
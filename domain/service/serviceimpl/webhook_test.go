@@ -0,0 +1,427 @@
+package serviceimpl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+	"github.com/quocdaitrn/cp-task/infra/repo/webhookimpl"
+)
+
+// fakeWebhookRepo keeps webhooks and deliveries in memory. Only methods used
+// for delivering webhooks are implemented.
+type fakeWebhookRepo struct {
+	store.WebhookRepo
+
+	mu         sync.Mutex
+	webhooks   map[uint]*entity.Webhook
+	deliveries []*entity.WebhookDelivery
+	attempts   []entity.WebhookAttempt
+}
+
+func newFakeWebhookRepo(webhook *entity.Webhook, deliveries ...*entity.WebhookDelivery) *fakeWebhookRepo {
+	return &fakeWebhookRepo{
+		webhooks:   map[uint]*entity.Webhook{webhook.ID: webhook},
+		deliveries: deliveries,
+	}
+}
+
+func (r *fakeWebhookRepo) InsertOne(_ context.Context, webhook *entity.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	webhook.ID = uint(len(r.webhooks) + 1)
+	r.webhooks[webhook.ID] = webhook
+
+	return nil
+}
+
+func (r *fakeWebhookRepo) ClaimDueDeliveries(_ context.Context, now time.Time, lease time.Duration, limit int) ([]entity.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	claimed := make([]entity.WebhookDelivery, 0)
+
+	for _, d := range r.deliveries {
+		if len(claimed) == limit {
+			break
+		}
+
+		hook := r.webhooks[d.WebhookID]
+		if d.Status != entity.WebhookDeliveryStatusPending || hook.Status != entity.WebhookStatusActive ||
+			d.NextAttemptAt == nil || d.NextAttemptAt.After(now) ||
+			(d.LockedUntil != nil && !d.LockedUntil.Before(now)) {
+			continue
+		}
+
+		lockedUntil := now.Add(lease)
+		d.LockedUntil = &lockedUntil
+
+		c := *d
+		h := *hook
+		c.Webhook = &h
+		claimed = append(claimed, c)
+	}
+
+	return claimed, nil
+}
+
+func (r *fakeWebhookRepo) RecordAttempt(_ context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempts = append(r.attempts, *attempt)
+
+	for i, d := range r.deliveries {
+		if d.ID == delivery.ID {
+			saved := *delivery
+			saved.Webhook = nil
+			saved.LockedUntil = nil
+			r.deliveries[i] = &saved
+		}
+	}
+
+	return nil
+}
+
+func (r *fakeWebhookRepo) RecordSuccess(_ context.Context, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.webhooks[id].FailureCount = 0
+
+	return nil
+}
+
+func (r *fakeWebhookRepo) RecordFailure(_ context.Context, id uint, disableAfter int, now time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hook := r.webhooks[id]
+	hook.FailureCount++
+
+	if hook.Status != entity.WebhookStatusActive || hook.FailureCount < disableAfter {
+		return false, nil
+	}
+
+	hook.Status = entity.WebhookStatusDisabled
+	hook.DisabledAt = &now
+
+	return true, nil
+}
+
+// delivery returns the saved state of delivery with given id.
+func (r *fakeWebhookRepo) delivery(id uint) entity.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			return *d
+		}
+	}
+
+	return entity.WebhookDelivery{}
+}
+
+// receivedRequest is a request received by a test endpoint.
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newTestEndpoint starts an endpoint answering every request with status,
+// and returns it along with requests it received.
+func newTestEndpoint(t *testing.T, status int) (*httptest.Server, func() []receivedRequest) {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		received []receivedRequest
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		received = append(received, receivedRequest{header: r.Header.Clone(), body: body})
+		mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []receivedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]receivedRequest(nil), received...)
+	}
+}
+
+func newTestWebhookService(repo store.WebhookRepo) *webhookService {
+	return NewWebhookService(repo, nil, webhookimpl.NewHTTPSender(time.Second, true), nil, nil).(*webhookService)
+}
+
+func newTestWebhook(url string) *entity.Webhook {
+	return &entity.Webhook{
+		ID:     1,
+		URL:    url,
+		Secret: "secret",
+		Events: entity.WebhookEvents{entity.WebhookEventTaskCreated},
+		Status: entity.WebhookStatusActive,
+	}
+}
+
+func newTestDelivery(id uint, webhookID uint, now time.Time) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:            id,
+		WebhookID:     webhookID,
+		Event:         entity.WebhookEventTaskCreated,
+		Payload:       `{"event":"task.created"}`,
+		Status:        entity.WebhookDeliveryStatusPending,
+		NextAttemptAt: &now,
+	}
+}
+
+func TestWebhookService_DeliverDueWebhooksSignsPayload(t *testing.T) {
+	server, received := newTestEndpoint(t, http.StatusOK)
+
+	now := time.Now()
+	hook := newTestWebhook(server.URL)
+	repo := newFakeWebhookRepo(hook, newTestDelivery(1, hook.ID, now))
+
+	attempted, err := newTestWebhookService(repo).DeliverDueWebhooks(context.Background(), now)
+	if err != nil {
+		t.Fatalf("deliver webhooks: %v", err)
+	}
+	if attempted != 1 {
+		t.Fatalf("attempted = %d, want 1", attempted)
+	}
+
+	requests := received()
+	if len(requests) != 1 {
+		t.Fatalf("received %d requests, want 1", len(requests))
+	}
+
+	req := requests[0]
+	if got, want := req.header.Get(webhookSignatureHeader), entity.SignWebhookPayload(hook.Secret, req.body); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+	if got := req.header.Get(webhookEventHeader); got != string(entity.WebhookEventTaskCreated) {
+		t.Errorf("event header = %q, want %q", got, entity.WebhookEventTaskCreated)
+	}
+	if got := string(req.body); got != `{"event":"task.created"}` {
+		t.Errorf("body = %q, want payload of delivery", got)
+	}
+
+	delivery := repo.delivery(1)
+	if delivery.Status != entity.WebhookDeliveryStatusSucceeded || delivery.DeliveredAt == nil {
+		t.Errorf("delivery status = %q, delivered at = %v, want succeeded", delivery.Status, delivery.DeliveredAt)
+	}
+
+	if len(repo.attempts) != 1 {
+		t.Fatalf("recorded %d attempts, want 1", len(repo.attempts))
+	}
+	if a := repo.attempts[0]; a.Attempt != 1 || a.ResponseStatus == nil || *a.ResponseStatus != http.StatusOK || a.Error != nil {
+		t.Errorf("attempt = %+v, want first attempt answered with 200", a)
+	}
+}
+
+func TestWebhookService_DeliverDueWebhooksRetriesWithBackoff(t *testing.T) {
+	server, received := newTestEndpoint(t, http.StatusInternalServerError)
+
+	now := time.Now()
+	hook := newTestWebhook(server.URL)
+	repo := newFakeWebhookRepo(hook, newTestDelivery(1, hook.ID, now))
+	svc := newTestWebhookService(repo)
+
+	for attempt := 1; attempt <= webhookMaxAttempts; attempt++ {
+		attempted, err := svc.DeliverDueWebhooks(context.Background(), now)
+		if err != nil {
+			t.Fatalf("deliver webhooks: %v", err)
+		}
+		if attempted != 1 {
+			t.Fatalf("attempt %d: attempted = %d, want 1", attempt, attempted)
+		}
+
+		delivery := repo.delivery(1)
+		if delivery.Attempts != attempt {
+			t.Fatalf("attempts = %d, want %d", delivery.Attempts, attempt)
+		}
+		if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusInternalServerError {
+			t.Errorf("attempt %d: response status = %v, want 500", attempt, delivery.ResponseStatus)
+		}
+
+		if attempt == webhookMaxAttempts {
+			if delivery.Status != entity.WebhookDeliveryStatusFailed || delivery.NextAttemptAt != nil {
+				t.Errorf("delivery status = %q, next attempt at = %v, want given up", delivery.Status, delivery.NextAttemptAt)
+			}
+			break
+		}
+
+		next := now.Add(webhookRetryDelay(attempt))
+		if delivery.Status != entity.WebhookDeliveryStatusPending || delivery.NextAttemptAt == nil || !delivery.NextAttemptAt.Equal(next) {
+			t.Fatalf("attempt %d: status = %q, next attempt at = %v, want pending until %v", attempt, delivery.Status, delivery.NextAttemptAt, next)
+		}
+
+		// Nothing is retried before the delay ends
+		attempted, err = svc.DeliverDueWebhooks(context.Background(), next.Add(-time.Second))
+		if err != nil {
+			t.Fatalf("deliver webhooks: %v", err)
+		}
+		if attempted != 0 {
+			t.Fatalf("attempt %d: attempted = %d before retry is due, want 0", attempt, attempted)
+		}
+
+		now = next
+	}
+
+	if got := len(received()); got != webhookMaxAttempts {
+		t.Errorf("received %d requests, want %d", got, webhookMaxAttempts)
+	}
+
+	if len(repo.attempts) != webhookMaxAttempts {
+		t.Fatalf("recorded %d attempts, want %d", len(repo.attempts), webhookMaxAttempts)
+	}
+	for i, a := range repo.attempts {
+		if a.Attempt != i+1 || a.Succeeded() {
+			t.Errorf("attempt %d = %+v, want failed attempt number %d", i, a, i+1)
+		}
+	}
+}
+
+func TestWebhookService_DeliverDueWebhooksDisablesFailingWebhook(t *testing.T) {
+	server, received := newTestEndpoint(t, http.StatusInternalServerError)
+
+	now := time.Now()
+	hook := newTestWebhook(server.URL)
+	hook.FailureCount = webhookDisableAfterFailures - 1
+	repo := newFakeWebhookRepo(hook, newTestDelivery(1, hook.ID, now), newTestDelivery(2, hook.ID, now))
+
+	attempted, err := newTestWebhookService(repo).DeliverDueWebhooks(context.Background(), now)
+	if err != nil {
+		t.Fatalf("deliver webhooks: %v", err)
+	}
+
+	// Remaining delivery waits for the webhook to be enabled again
+	if attempted != 1 || len(received()) != 1 {
+		t.Errorf("attempted = %d, received = %d, want 1 each", attempted, len(received()))
+	}
+
+	if hook.Status != entity.WebhookStatusDisabled || hook.DisabledAt == nil {
+		t.Errorf("webhook status = %q, disabled at = %v, want disabled", hook.Status, hook.DisabledAt)
+	}
+	if hook.FailureCount != webhookDisableAfterFailures {
+		t.Errorf("failure count = %d, want %d", hook.FailureCount, webhookDisableAfterFailures)
+	}
+
+	if d := repo.delivery(2); d.Attempts != 0 || d.Status != entity.WebhookDeliveryStatusPending {
+		t.Errorf("second delivery = %+v, want pending and not attempted", d)
+	}
+}
+
+// fakeRoleBindingRepo keeps role bindings on whole tenant in memory. Only
+// methods used for granting and checking them are implemented.
+type fakeRoleBindingRepo struct {
+	store.RoleBindingRepo
+
+	roles map[uint]entity.Role
+}
+
+func (r *fakeRoleBindingRepo) UpsertOne(_ context.Context, binding *entity.RoleBinding) error {
+	r.roles[binding.UserID] = binding.Role
+
+	return nil
+}
+
+func (r *fakeRoleBindingRepo) FindRoles(_ context.Context, userID uint, _ *uint) ([]entity.Role, error) {
+	if role, ok := r.roles[userID]; ok {
+		return []entity.Role{role}, nil
+	}
+
+	return nil, nil
+}
+
+// fakeUserRepo knows every user.
+type fakeUserRepo struct{}
+
+func (fakeUserRepo) GetUsersByIDs(_ context.Context, ids []uint) ([]entity.SimpleUser, error) {
+	users := make([]entity.SimpleUser, len(ids))
+	for i, id := range ids {
+		users[i] = entity.SimpleUser{ID: id}
+	}
+
+	return users, nil
+}
+
+func (fakeUserRepo) GetUserByID(_ context.Context, id uint) (*entity.SimpleUser, error) {
+	return &entity.SimpleUser{ID: id}, nil
+}
+
+// userUID returns masked id of user with given local id.
+func userUID(id uint) string {
+	uid := core.NewUID(uint32(id), entity.MaskTypeUser, 1)
+	return uid.String()
+}
+
+// userContext returns a context of user with given local id as requester.
+func userContext(id uint) context.Context {
+	return kitcontext.WithUID(context.Background(), kitcontext.UID{Sub: userUID(id), Tid: "tenant"})
+}
+
+func TestWebhookService_CreateWebhookRequiresTenantAdmin(t *testing.T) {
+	v, err := validator.New()
+	if err != nil {
+		t.Fatalf("create validator: %v", err)
+	}
+
+	// User 1 is the first admin, as granted from command line
+	roleBindingRepo := &fakeRoleBindingRepo{roles: map[uint]entity.Role{1: entity.RoleAdmin}}
+	authorizer := NewRoleAuthorizer(roleBindingRepo, nil, nil, nil)
+	tenantSvc := NewTenantService(roleBindingRepo, authorizer, fakeUserRepo{}, v)
+	webhookSvc := NewWebhookService(newFakeWebhookRepo(newTestWebhook("https://example.com")), nil, nil, authorizer, v)
+
+	req := &service.CreateWebhookRequest{URL: "https://example.com/hooks"}
+
+	if _, err := webhookSvc.CreateWebhook(userContext(2), req); !kiterrors.ErrForbidden.Equal(err) {
+		t.Fatalf("create webhook without role: err = %v, want forbidden", err)
+	}
+
+	// Editors of the tenant can not manage its webhooks either
+	if _, err := tenantSvc.AddTenantMember(userContext(1), &service.AddTenantMemberRequest{UserID: userUID(2), Role: string(entity.RoleEditor)}); err != nil {
+		t.Fatalf("grant editor role: %v", err)
+	}
+	if _, err := webhookSvc.CreateWebhook(userContext(2), req); !kiterrors.ErrForbidden.Equal(err) {
+		t.Fatalf("create webhook as editor: err = %v, want forbidden", err)
+	}
+
+	if _, err := tenantSvc.AddTenantMember(userContext(1), &service.AddTenantMemberRequest{UserID: userUID(2), Role: string(entity.RoleAdmin)}); err != nil {
+		t.Fatalf("grant admin role: %v", err)
+	}
+
+	res, err := webhookSvc.CreateWebhook(userContext(2), req)
+	if err != nil {
+		t.Fatalf("create webhook as admin: %v", err)
+	}
+	if res.ID == nil || res.Secret == "" {
+		t.Errorf("response = %+v, want id and generated secret", res)
+	}
+
+	// Only admins grant roles on whole tenant
+	if _, err := tenantSvc.AddTenantMember(userContext(3), &service.AddTenantMemberRequest{UserID: userUID(3), Role: string(entity.RoleAdmin)}); !kiterrors.ErrForbidden.Equal(err) {
+		t.Errorf("grant admin role to oneself: err = %v, want forbidden", err)
+	}
+}
@@ -0,0 +1,326 @@
+package serviceimpl
+
+import (
+	"context"
+	"fmt"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/quocdaitrn/golang-kit/validator"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/domain/service"
+)
+
+type workflowService struct {
+	workflowRepo store.WorkflowRepo
+	taskRepo     store.TaskRepo
+	projectRepo  store.ProjectRepo
+	authorizer   service.Authorizer
+	validator    validator.Validator
+}
+
+// NewWorkflowService creates and returns a new instance of WorkflowService.
+func NewWorkflowService(
+	workflowRepo store.WorkflowRepo,
+	taskRepo store.TaskRepo,
+	projectRepo store.ProjectRepo,
+	authorizer service.Authorizer,
+	validator validator.Validator,
+) service.WorkflowService {
+	return &workflowService{
+		workflowRepo: workflowRepo,
+		taskRepo:     taskRepo,
+		projectRepo:  projectRepo,
+		authorizer:   authorizer,
+		validator:    validator,
+	}
+}
+
+// CreateWorkflow creates a new workflow.
+func (s *workflowService) CreateWorkflow(ctx context.Context, req *service.CreateWorkflowRequest) (*service.CreateWorkflowResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	uid := kitcontext.UIDFromContext(ctx)
+	id, _ := core.FromBase58(uid.Sub)
+	requesterID := uint(id.GetLocalID())
+
+	statuses, transitions, err := buildWorkflowDetails(req.Statuses, req.Transitions)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := &entity.Workflow{
+		UserID:      requesterID,
+		Name:        req.Name,
+		Statuses:    statuses,
+		Transitions: transitions,
+	}
+
+	// Only project admins can set up workflows of their project
+	if req.ProjectID != nil && *req.ProjectID != "" {
+		project, err := s.findProject(ctx, *req.ProjectID, entity.ActionManage)
+		if err != nil {
+			return nil, err
+		}
+		workflow.ProjectID = &project.ID
+	}
+
+	if err := s.workflowRepo.InsertOne(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	return &service.CreateWorkflowResponse{Message: "create workflow successfully"}, nil
+}
+
+// GetWorkflow finds and returns a specific workflow.
+func (s *workflowService) GetWorkflow(ctx context.Context, req *service.GetWorkflowRequest) (*service.GetWorkflowResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	workflow, err := s.findWorkflow(ctx, req.ID, entity.ActionView)
+	if err != nil {
+		return nil, err
+	}
+	workflow.Mask()
+
+	return &service.GetWorkflowResponse{Workflow: workflow}, nil
+}
+
+// ListWorkflows finds and returns a list of workflows of a project, or of the
+// whole tenant.
+func (s *workflowService) ListWorkflows(ctx context.Context, req *service.ListWorkflowsRequest) (*service.ListWorkflowsResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	filter := &entity.WorkflowFilter{}
+
+	if req.ProjectID != nil && *req.ProjectID != "" {
+		project, err := s.findProject(ctx, *req.ProjectID, entity.ActionView)
+		if err != nil {
+			return nil, err
+		}
+		filter.ProjectID = &project.ID
+	}
+
+	paging := &core.Paging{
+		Page:  req.Page,
+		Limit: req.Limit,
+	}
+	workflows, err := s.workflowRepo.FindRangeByCriteria(ctx, filter, paging)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range workflows {
+		workflows[i].Mask()
+	}
+
+	return &service.ListWorkflowsResponse{
+		Items:   workflows,
+		HasNext: paging.Total > int64(req.Page*req.Limit),
+		Page:    uint(req.Page),
+		Limit:   uint(req.Limit),
+	}, nil
+}
+
+// UpdateWorkflow updates a specific workflow.
+func (s *workflowService) UpdateWorkflow(ctx context.Context, req *service.UpdateWorkflowRequest) (*service.UpdateWorkflowResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	workflow, err := s.findWorkflow(ctx, req.ID, entity.ActionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && *req.Name != "" {
+		workflow.Name = *req.Name
+	}
+
+	if len(req.Statuses) > 0 {
+		statuses, transitions, err := buildWorkflowDetails(req.Statuses, req.Transitions)
+		if err != nil {
+			return nil, err
+		}
+
+		// Statuses still used by tasks can not be removed
+		kept := make(map[entity.Status]bool, len(statuses))
+		for _, st := range statuses {
+			kept[st.Key] = true
+		}
+
+		for _, st := range workflow.Statuses {
+			if kept[st.Key] {
+				continue
+			}
+
+			inUse, err := s.isInUse(ctx, workflow.ID, &st.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			if inUse {
+				return nil, kiterrors.ErrForbidden.WithDetails(fmt.Sprintf("status %q is still used by tasks", st.Key))
+			}
+		}
+
+		workflow.Statuses = statuses
+		workflow.Transitions = transitions
+	}
+
+	if err := s.workflowRepo.UpdateOne(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	return &service.UpdateWorkflowResponse{Message: "update workflow successfully"}, nil
+}
+
+// DeleteWorkflow deletes a specific workflow.
+func (s *workflowService) DeleteWorkflow(ctx context.Context, req *service.DeleteWorkflowRequest) (*service.DeleteWorkflowResponse, error) {
+	if err := s.validator.Validate(req); err != nil {
+		return nil, kiterrors.WithStack(err)
+	}
+
+	workflow, err := s.findWorkflow(ctx, req.ID, entity.ActionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	inUse, err := s.isInUse(ctx, workflow.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if inUse {
+		return nil, kiterrors.ErrForbidden.WithDetails("workflow is still used by tasks")
+	}
+
+	if err := s.workflowRepo.DeleteOne(ctx, workflow.ID); err != nil {
+		return nil, err
+	}
+
+	return &service.DeleteWorkflowResponse{Message: "delete workflow successfully"}, nil
+}
+
+// findWorkflow fetches a workflow by its masked id, making sure requester can
+// do action on it.
+func (s *workflowService) findWorkflow(ctx context.Context, workflowID string, action entity.Action) (*entity.Workflow, error) {
+	cUID, err := core.FromBase58(workflowID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound
+	}
+
+	workflow, err := s.workflowRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	if err := s.authorizer.AuthorizeWorkflow(ctx, workflow, action); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// findProject fetches a project by its masked id, making sure requester can
+// do action on it. Deleted project is seen as not existing.
+func (s *workflowService) findProject(ctx context.Context, projectID string, action entity.Action) (*entity.Project, error) {
+	cUID, err := core.FromBase58(projectID)
+	if err != nil {
+		return nil, kiterrors.ErrNotFound.WithDetails("project not found")
+	}
+
+	project, err := s.projectRepo.FindOne(ctx, uint(cUID.GetLocalID()))
+	if err != nil {
+		if err == kiterrors.ErrRepoEntityNotFound {
+			return nil, kiterrors.ErrNotFound.WithDetails("project not found")
+		}
+
+		return nil, err
+	}
+
+	if project.Status == entity.ProjectStatusDeleted {
+		return nil, kiterrors.ErrNotFound.WithDetails("project not found")
+	}
+
+	if err := s.authorizer.AuthorizeProject(ctx, project, action); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// isInUse reports whether any task uses workflow, or a specific status of it
+// if status is given. Tasks in trash or archive count, as they can be
+// restored.
+func (s *workflowService) isInUse(ctx context.Context, workflowID uint, status *entity.Status) (bool, error) {
+	count, err := s.taskRepo.CountByWorkflow(ctx, workflowID, status)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// buildWorkflowDetails validates and converts statuses and transitions of a
+// workflow from request.
+func buildWorkflowDetails(
+	statusInputs []service.WorkflowStatusInput,
+	transitionInputs []service.WorkflowTransitionInput,
+) ([]entity.WorkflowStatus, []entity.WorkflowTransition, error) {
+	statuses := make([]entity.WorkflowStatus, len(statusInputs))
+	keys := make(map[entity.Status]bool, len(statusInputs))
+
+	for i, in := range statusInputs {
+		key := entity.Status(in.Key)
+
+		// Deleted and archived are reserved for deleting and archiving tasks
+		if key == entity.StatusDeleted || key == entity.StatusArchived {
+			return nil, nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"statuses": fmt.Sprintf("status %q is reserved", key)})
+		}
+
+		if keys[key] {
+			return nil, nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"statuses": fmt.Sprintf("status %q is duplicated", key)})
+		}
+		keys[key] = true
+
+		statuses[i] = entity.WorkflowStatus{
+			Key:      key,
+			Name:     in.Name,
+			Category: entity.StatusCategory(in.Category),
+			Position: i,
+		}
+	}
+
+	transitions := make([]entity.WorkflowTransition, 0, len(transitionInputs))
+	seen := make(map[entity.WorkflowTransition]bool, len(transitionInputs))
+
+	for _, in := range transitionInputs {
+		t := entity.WorkflowTransition{From: entity.Status(in.From), To: entity.Status(in.To)}
+
+		if !keys[t.From] || !keys[t.To] {
+			return nil, nil, kiterrors.ErrInvalidRequest.WithDetails(map[string]string{"transitions": fmt.Sprintf("transition %q -> %q uses unknown status", t.From, t.To)})
+		}
+
+		if t.From == t.To || seen[t] {
+			continue
+		}
+		seen[t] = true
+
+		transitions = append(transitions, t)
+	}
+
+	return statuses, transitions, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// ShareLinkService exposes all available use cases of public share links of
+// tasks.
+type ShareLinkService interface {
+	// CreateShareLink mints a new share link of a specific task.
+	CreateShareLink(ctx context.Context, req *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+
+	// ListShareLinks finds and returns share links of a specific task.
+	ListShareLinks(ctx context.Context, req *ListShareLinksRequest) (*ListShareLinksResponse, error)
+
+	// RevokeShareLink revokes a share link of a specific task.
+	RevokeShareLink(ctx context.Context, req *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+
+	// GetSharedTask finds and returns the task of a share link, without
+	// requiring requester to be authenticated.
+	GetSharedTask(ctx context.Context, req *GetSharedTaskRequest) (*GetSharedTaskResponse, error)
+}
+
+// CreateShareLinkRequest represent a request to create a share link of a
+// task.
+type CreateShareLinkRequest struct {
+	TaskID    string     `json:"-" param:"id" validate:"required"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateShareLinkResponse represent a response for creating a share link of
+// a task. Token is only returned once, when link is created.
+type CreateShareLinkResponse struct {
+	Message   string     `json:"message"`
+	ID        *core.UID  `json:"id"`
+	Token     string     `json:"token"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// ListShareLinksRequest represent a request to get share links of a task.
+type ListShareLinksRequest struct {
+	TaskID string `param:"id" validate:"required"`
+}
+
+// ListShareLinksResponse represent a response for listing share links of a
+// task.
+type ListShareLinksResponse struct {
+	Items []entity.ShareLink `json:"items"`
+}
+
+// RevokeShareLinkRequest represent a request to revoke a share link of a
+// task.
+type RevokeShareLinkRequest struct {
+	TaskID string `param:"id" validate:"required"`
+	LinkID string `param:"link_id" validate:"required"`
+}
+
+// RevokeShareLinkResponse represent a response for revoking a share link of
+// a task.
+type RevokeShareLinkResponse struct {
+	Message string `json:"message"`
+}
+
+// GetSharedTaskRequest represent a request to get a task by a share link.
+type GetSharedTaskRequest struct {
+	Token      string `json:"-" param:"token" validate:"required"`
+	RemoteAddr string `json:"-"`
+	UserAgent  string `json:"-"`
+}
+
+// GetSharedTaskResponse represent a response for getting a task by a share
+// link.
+type GetSharedTaskResponse struct {
+	*entity.SharedTask
+}
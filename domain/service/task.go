@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/quocdaitrn/cp-task/domain/entity"
 )
@@ -22,12 +23,86 @@ type TaskService interface {
 
 	// DeleteTask deletes a specific task.
 	DeleteTask(ctx context.Context, req *DeleteTaskRequest) (*DeleteTaskResponse, error)
+
+	// AddTaskLabels attaches labels to a specific task.
+	AddTaskLabels(ctx context.Context, req *AddTaskLabelsRequest) (*AddTaskLabelsResponse, error)
+
+	// RemoveTaskLabel detaches a label from a specific task.
+	RemoveTaskLabel(ctx context.Context, req *RemoveTaskLabelRequest) (*RemoveTaskLabelResponse, error)
+
+	// AssignTask assigns users to a specific task.
+	AssignTask(ctx context.Context, req *AssignTaskRequest) (*AssignTaskResponse, error)
+
+	// UnassignTask unassigns an user from a specific task.
+	UnassignTask(ctx context.Context, req *UnassignTaskRequest) (*UnassignTaskResponse, error)
+
+	// ShareTask shares a specific task with an user.
+	ShareTask(ctx context.Context, req *ShareTaskRequest) (*ShareTaskResponse, error)
+
+	// UnshareTask stops sharing a specific task with an user.
+	UnshareTask(ctx context.Context, req *UnshareTaskRequest) (*UnshareTaskResponse, error)
+
+	// ListTaskShares finds and returns users a specific task is shared with.
+	ListTaskShares(ctx context.Context, req *ListTaskSharesRequest) (*ListTaskSharesResponse, error)
+
+	// WatchTask makes requester follow changes of a specific task.
+	WatchTask(ctx context.Context, req *WatchTaskRequest) (*WatchTaskResponse, error)
+
+	// UnwatchTask makes requester stop following changes of a specific task.
+	UnwatchTask(ctx context.Context, req *UnwatchTaskRequest) (*UnwatchTaskResponse, error)
+
+	// ListTaskWatchers finds and returns users following changes of a
+	// specific task.
+	ListTaskWatchers(ctx context.Context, req *ListTaskWatchersRequest) (*ListTaskWatchersResponse, error)
+
+	// ListSubtasks finds and returns a list of subtasks of a specific task.
+	ListSubtasks(ctx context.Context, req *ListSubtasksRequest) (*ListSubtasksResponse, error)
+
+	// MoveTask moves a specific task under another parent task, or to top
+	// level if no parent is given.
+	MoveTask(ctx context.Context, req *MoveTaskRequest) (*MoveTaskResponse, error)
+
+	// ListTaskActivities finds and returns history of changes of a specific
+	// task.
+	ListTaskActivities(ctx context.Context, req *ListTaskActivitiesRequest) (*ListTaskActivitiesResponse, error)
+
+	// ListProjectTasks finds and returns a list of tasks of a specific project.
+	ListProjectTasks(ctx context.Context, req *ListProjectTasksRequest) (*ListProjectTasksResponse, error)
+
+	// AddTaskDependency marks a specific task as blocked by another task.
+	AddTaskDependency(ctx context.Context, req *AddTaskDependencyRequest) (*AddTaskDependencyResponse, error)
+
+	// RemoveTaskDependency removes a blocker from a specific task.
+	RemoveTaskDependency(ctx context.Context, req *RemoveTaskDependencyRequest) (*RemoveTaskDependencyResponse, error)
+
+	// ArchiveTask archives a specific task, hiding it from lists of tasks.
+	ArchiveTask(ctx context.Context, req *ArchiveTaskRequest) (*ArchiveTaskResponse, error)
+
+	// UnarchiveTask brings a specific archived task back to its previous
+	// status.
+	UnarchiveTask(ctx context.Context, req *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error)
+
+	// ListTrash finds and returns a list of deleted tasks of requester.
+	ListTrash(ctx context.Context, req *ListTrashRequest) (*ListTrashResponse, error)
+
+	// RestoreTask restores a specific deleted task to its previous status.
+	RestoreTask(ctx context.Context, req *RestoreTaskRequest) (*RestoreTaskResponse, error)
+
+	// PurgeTask permanently deletes a specific deleted task.
+	PurgeTask(ctx context.Context, req *PurgeTaskRequest) (*PurgeTaskResponse, error)
 }
 
 // CreateNewTaskRequest represent a request to create a task.
 type CreateNewTaskRequest struct {
-	Title       string `json:"title" validate:"required,max=256"`
-	Description string `json:"description" validate:"required"`
+	Title       string     `json:"title" validate:"required,max=256"`
+	Description string     `json:"description" validate:"required"`
+	ParentID    *string    `json:"parent_id"`
+	ProjectID   *string    `json:"project_id"`
+	WorkflowID  *string    `json:"workflow_id"`
+	Priority    *string    `json:"priority" validate:"omitempty,oneof=none low medium high urgent"`
+	StartAt     *time.Time `json:"start_at"`
+	DueAt       *time.Time `json:"due_at"`
+	Recurrence  *string    `json:"recurrence" validate:"omitempty,max=256"`
 }
 
 // CreateNewTaskResponse represent a response for creating a task.
@@ -47,10 +122,26 @@ type GetTaskResponse struct {
 
 // ListTasksRequest represent a request to get a list of tasks.
 type ListTasksRequest struct {
-	UserID *uint   `json:"-" query:"user_id" field:"user_id"`
-	Status *string `json:"-" query:"status" field:"status"`
-	Page   int     `json:"-" query:"page" field:"page" validate:"gte=1"`
-	Limit  int     `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+	UserID          *uint    `json:"-" query:"user_id" field:"user_id"`
+	AssignedToMe    *bool    `json:"-" query:"assigned_to_me" field:"assigned_to_me"`
+	MentionsMe      *bool    `json:"-" query:"mentions_me" field:"mentions_me"`
+	Watching        *bool    `json:"-" query:"watching" field:"watching"`
+	ProjectID       *string  `json:"-" query:"project_id" field:"project_id"`
+	Status          *string  `json:"-" query:"status" field:"status" validate:"omitempty,max=32"`
+	StatusCategory  *string  `json:"-" query:"status_category" field:"status_category" validate:"omitempty,oneof=open in_progress closed"`
+	Priority        *string  `json:"-" query:"priority" field:"priority" validate:"omitempty,oneof=none low medium high urgent"`
+	DueBefore       *string  `json:"-" query:"due_before" field:"due_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	DueAfter        *string  `json:"-" query:"due_after" field:"due_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Overdue         *bool    `json:"-" query:"overdue" field:"overdue"`
+	Blocked         *bool    `json:"-" query:"blocked" field:"blocked"`
+	IncludeArchived *bool    `json:"-" query:"include_archived" field:"include_archived"`
+	ArchivedOnly    *bool    `json:"-" query:"archived_only" field:"archived_only"`
+	LabelIDs        []string `json:"-" query:"label_ids" field:"label_ids"`
+	LabelMatch      string   `json:"-" query:"label_match" field:"label_match" validate:"omitempty,oneof=any all"`
+	SortBy          string   `json:"-" query:"sort_by" field:"sort_by" validate:"omitempty,oneof=priority due_at created_at updated_at title"`
+	SortOrder       string   `json:"-" query:"sort_order" field:"sort_order" validate:"omitempty,oneof=asc desc"`
+	Page            int      `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit           int      `json:"-" query:"limit" field:"limit" validate:"gte=1"`
 }
 
 // ListTasksResponse represent a response for listing tasks.
@@ -63,10 +154,14 @@ type ListTasksResponse struct {
 
 // UpdateTaskRequest represent a request to update a task.
 type UpdateTaskRequest struct {
-	ID          string  `json:"-"  param:"id" validate:"required"`
-	Title       *string `json:"title" validate:"max=256"`
-	Description *string `json:"description"`
-	Status      *string `json:"status"`
+	ID          string     `json:"-"  param:"id" validate:"required"`
+	Title       *string    `json:"title" validate:"max=256"`
+	Description *string    `json:"description"`
+	Status      *string    `json:"status" validate:"omitempty,max=32"`
+	Priority    *string    `json:"priority" validate:"omitempty,oneof=none low medium high urgent"`
+	StartAt     *time.Time `json:"start_at"`
+	DueAt       *time.Time `json:"due_at"`
+	Recurrence  *string    `json:"recurrence" validate:"max=256"`
 }
 
 // UpdateTaskResponse represent a response for updating a task.
@@ -83,3 +178,255 @@ type DeleteTaskRequest struct {
 type DeleteTaskResponse struct {
 	Message string `json:"message"`
 }
+
+// AddTaskLabelsRequest represent a request to attach labels to a task.
+type AddTaskLabelsRequest struct {
+	ID       string   `json:"-" param:"id" validate:"required"`
+	LabelIDs []string `json:"label_ids" validate:"required,min=1"`
+}
+
+// AddTaskLabelsResponse represent a response for attaching labels to a task.
+type AddTaskLabelsResponse struct {
+	Message string `json:"message"`
+}
+
+// RemoveTaskLabelRequest represent a request to detach a label from a task.
+type RemoveTaskLabelRequest struct {
+	ID      string `param:"id" validate:"required"`
+	LabelID string `param:"label_id" validate:"required"`
+}
+
+// RemoveTaskLabelResponse represent a response for detaching a label from a
+// task.
+type RemoveTaskLabelResponse struct {
+	Message string `json:"message"`
+}
+
+// AssignTaskRequest represent a request to assign users to a task.
+type AssignTaskRequest struct {
+	ID      string   `json:"-" param:"id" validate:"required"`
+	UserIDs []string `json:"user_ids" validate:"required,min=1"`
+}
+
+// AssignTaskResponse represent a response for assigning users to a task.
+type AssignTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// UnassignTaskRequest represent a request to unassign an user from a task.
+type UnassignTaskRequest struct {
+	ID     string `param:"id" validate:"required"`
+	UserID string `param:"user_id" validate:"required"`
+}
+
+// UnassignTaskResponse represent a response for unassigning an user from a
+// task.
+type UnassignTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// ShareTaskRequest represent a request to share a task with an user.
+type ShareTaskRequest struct {
+	ID         string `json:"-" param:"id" validate:"required"`
+	UserID     string `json:"user_id" validate:"required"`
+	Permission string `json:"permission" validate:"required,oneof=read edit"`
+}
+
+// ShareTaskResponse represent a response for sharing a task with an user.
+type ShareTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// UnshareTaskRequest represent a request to stop sharing a task with an user.
+type UnshareTaskRequest struct {
+	ID     string `param:"id" validate:"required"`
+	UserID string `param:"user_id" validate:"required"`
+}
+
+// UnshareTaskResponse represent a response for stopping sharing a task with
+// an user.
+type UnshareTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// ListTaskSharesRequest represent a request to get users a task is shared
+// with.
+type ListTaskSharesRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// ListTaskSharesResponse represent a response for listing users a task is
+// shared with.
+type ListTaskSharesResponse struct {
+	Items []entity.TaskShare `json:"items"`
+}
+
+// WatchTaskRequest represent a request to watch a task.
+type WatchTaskRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// WatchTaskResponse represent a response for watching a task.
+type WatchTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// UnwatchTaskRequest represent a request to stop watching a task.
+type UnwatchTaskRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// UnwatchTaskResponse represent a response for stopping watching a task.
+type UnwatchTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// ListTaskWatchersRequest represent a request to get users watching a task.
+type ListTaskWatchersRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// ListTaskWatchersResponse represent a response for listing users watching a
+// task.
+type ListTaskWatchersResponse struct {
+	Items []entity.SimpleUser `json:"items"`
+}
+
+// ListSubtasksRequest represent a request to get a list of subtasks of a task.
+type ListSubtasksRequest struct {
+	ID    string `json:"-" param:"id" validate:"required"`
+	Page  int    `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int    `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListSubtasksResponse represent a response for listing subtasks of a task.
+type ListSubtasksResponse struct {
+	Items   []entity.Task `json:"items"`
+	HasNext bool          `json:"has_next"`
+	Page    uint          `json:"page"`
+	Limit   uint          `json:"limit"`
+}
+
+// MoveTaskRequest represent a request to move a task under another parent.
+type MoveTaskRequest struct {
+	ID       string  `json:"-" param:"id" validate:"required"`
+	ParentID *string `json:"parent_id"`
+}
+
+// MoveTaskResponse represent a response for moving a task.
+type MoveTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// ListTaskActivitiesRequest represent a request to get history of changes of
+// a task.
+type ListTaskActivitiesRequest struct {
+	ID    string `json:"-" param:"id" validate:"required"`
+	Page  int    `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int    `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListTaskActivitiesResponse represent a response for listing history of
+// changes of a task.
+type ListTaskActivitiesResponse struct {
+	Items   []entity.Activity `json:"items"`
+	HasNext bool              `json:"has_next"`
+	Page    uint              `json:"page"`
+	Limit   uint              `json:"limit"`
+}
+
+// ListProjectTasksRequest represent a request to get a list of tasks of a
+// project.
+type ListProjectTasksRequest struct {
+	ProjectID string `json:"-" param:"id" validate:"required"`
+	Page      int    `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit     int    `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListProjectTasksResponse represent a response for listing tasks of a
+// project.
+type ListProjectTasksResponse struct {
+	Items   []entity.Task `json:"items"`
+	HasNext bool          `json:"has_next"`
+	Page    uint          `json:"page"`
+	Limit   uint          `json:"limit"`
+}
+
+// AddTaskDependencyRequest represent a request to mark a task as blocked by
+// another task.
+type AddTaskDependencyRequest struct {
+	ID        string `json:"-" param:"id" validate:"required"`
+	BlockerID string `json:"blocker_id" validate:"required"`
+}
+
+// AddTaskDependencyResponse represent a response for adding a dependency.
+type AddTaskDependencyResponse struct {
+	Message string `json:"message"`
+}
+
+// RemoveTaskDependencyRequest represent a request to remove a blocker from a
+// task.
+type RemoveTaskDependencyRequest struct {
+	ID        string `param:"id" validate:"required"`
+	BlockerID string `param:"blocker_id" validate:"required"`
+}
+
+// RemoveTaskDependencyResponse represent a response for removing a
+// dependency.
+type RemoveTaskDependencyResponse struct {
+	Message string `json:"message"`
+}
+
+// ListTrashRequest represent a request to get a list of deleted tasks.
+type ListTrashRequest struct {
+	Page  int `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListTrashResponse represent a response for listing deleted tasks.
+type ListTrashResponse struct {
+	Items   []entity.Task `json:"items"`
+	HasNext bool          `json:"has_next"`
+	Page    uint          `json:"page"`
+	Limit   uint          `json:"limit"`
+}
+
+// RestoreTaskRequest represent a request to restore a deleted task.
+type RestoreTaskRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// RestoreTaskResponse represent a response for restoring a deleted task.
+type RestoreTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// PurgeTaskRequest represent a request to permanently delete a task.
+type PurgeTaskRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// PurgeTaskResponse represent a response for permanently deleting a task.
+type PurgeTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// ArchiveTaskRequest represent a request to archive a task.
+type ArchiveTaskRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// ArchiveTaskResponse represent a response for archiving a task.
+type ArchiveTaskResponse struct {
+	Message string `json:"message"`
+}
+
+// UnarchiveTaskRequest represent a request to unarchive a task.
+type UnarchiveTaskRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// UnarchiveTaskResponse represent a response for unarchiving a task.
+type UnarchiveTaskResponse struct {
+	Message string `json:"message"`
+}
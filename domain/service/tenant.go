@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// TenantService exposes all available use cases of tenant domain.
+type TenantService interface {
+	// AddTenantMember grants a role on whole tenant to an user.
+	AddTenantMember(ctx context.Context, req *AddTenantMemberRequest) (*AddTenantMemberResponse, error)
+
+	// RemoveTenantMember revokes role of an user on whole tenant.
+	RemoveTenantMember(ctx context.Context, req *RemoveTenantMemberRequest) (*RemoveTenantMemberResponse, error)
+
+	// ListTenantMembers finds and returns a list of users having a role on
+	// whole tenant.
+	ListTenantMembers(ctx context.Context, req *ListTenantMembersRequest) (*ListTenantMembersResponse, error)
+}
+
+// AddTenantMemberRequest represent a request to grant a role on whole tenant.
+type AddTenantMemberRequest struct {
+	UserID string `json:"user_id" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=viewer editor admin"`
+}
+
+// AddTenantMemberResponse represent a response for granting a role on whole
+// tenant.
+type AddTenantMemberResponse struct {
+	Message string `json:"message"`
+}
+
+// RemoveTenantMemberRequest represent a request to revoke a role on whole
+// tenant.
+type RemoveTenantMemberRequest struct {
+	UserID string `param:"user_id" validate:"required"`
+}
+
+// RemoveTenantMemberResponse represent a response for revoking a role on whole
+// tenant.
+type RemoveTenantMemberResponse struct {
+	Message string `json:"message"`
+}
+
+// ListTenantMembersRequest represent a request to get a list of users having a
+// role on whole tenant.
+type ListTenantMembersRequest struct {
+	Page  int `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListTenantMembersResponse represent a response for listing users having a
+// role on whole tenant.
+type ListTenantMembersResponse struct {
+	Items   []entity.RoleBinding `json:"items"`
+	HasNext bool                 `json:"has_next"`
+	Page    uint                 `json:"page"`
+	Limit   uint                 `json:"limit"`
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// WebhookService exposes all available use cases of webhook domain. Webhooks
+// belong to whole tenant, so managing them requires admin role on the tenant.
+type WebhookService interface {
+	// CreateWebhook subscribes an endpoint to changes on tasks of the tenant.
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookResponse, error)
+
+	// ListWebhooks finds and returns webhooks of the tenant, newest first.
+	ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error)
+
+	// UpdateWebhook updates a specific webhook, enabling it again if asked.
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+
+	// DeleteWebhook deletes a specific webhook along with its deliveries.
+	DeleteWebhook(ctx context.Context, req *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+
+	// ListWebhookDeliveries finds and returns deliveries of a specific
+	// webhook, newest first.
+	ListWebhookDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+
+	// ListWebhookDeliveryAttempts finds and returns attempts of a specific
+	// delivery, in order.
+	ListWebhookDeliveryAttempts(ctx context.Context, req *ListWebhookDeliveryAttemptsRequest) (*ListWebhookDeliveryAttemptsResponse, error)
+
+	// DispatchTaskEvent queues a delivery of an event on a task to every
+	// active webhook of the tenant subscribing to it. It is run by other use
+	// cases rather than requested by users.
+	DispatchTaskEvent(ctx context.Context, event entity.WebhookEvent, task *entity.Task, actorID uint) error
+
+	// DeliverDueWebhooks attempts deliveries of all tenants which are due at
+	// the given time, and returns how many were attempted. It is run by the
+	// system rather than requested by users.
+	DeliverDueWebhooks(ctx context.Context, now time.Time) (int, error)
+}
+
+// CreateWebhookRequest represent a request to create a webhook. A secret is
+// generated when none is given. Without events, webhook receives all of them.
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,url,max=2048"`
+	Secret string   `json:"secret" validate:"omitempty,min=16,max=255"`
+	Events []string `json:"events" validate:"dive,oneof=task.created task.updated task.deleted task.purged"`
+}
+
+// CreateWebhookResponse represent a response for creating a webhook. Secret
+// is only returned once, when webhook is created.
+type CreateWebhookResponse struct {
+	Message string    `json:"message"`
+	ID      *core.UID `json:"id"`
+	Secret  string    `json:"secret"`
+}
+
+// ListWebhooksRequest represent a request to get webhooks of the tenant.
+type ListWebhooksRequest struct {
+	Page  int `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListWebhooksResponse represent a response for listing webhooks of the
+// tenant.
+type ListWebhooksResponse struct {
+	Items   []entity.Webhook `json:"items"`
+	HasNext bool             `json:"has_next"`
+	Page    uint             `json:"page"`
+	Limit   uint             `json:"limit"`
+}
+
+// UpdateWebhookRequest represent a request to update a webhook. Setting
+// active to true enables a disabled webhook again.
+type UpdateWebhookRequest struct {
+	ID     string    `json:"-" param:"id" validate:"required"`
+	URL    *string   `json:"url" validate:"omitempty,url,max=2048"`
+	Secret *string   `json:"secret" validate:"omitempty,min=16,max=255"`
+	Events *[]string `json:"events" validate:"omitempty,dive,oneof=task.created task.updated task.deleted task.purged"`
+	Active *bool     `json:"active"`
+}
+
+// UpdateWebhookResponse represent a response for updating a webhook.
+type UpdateWebhookResponse struct {
+	Message string `json:"message"`
+}
+
+// DeleteWebhookRequest represent a request to delete a webhook.
+type DeleteWebhookRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// DeleteWebhookResponse represent a response for deleting a webhook.
+type DeleteWebhookResponse struct {
+	Message string `json:"message"`
+}
+
+// ListWebhookDeliveriesRequest represent a request to get deliveries of a
+// webhook.
+type ListWebhookDeliveriesRequest struct {
+	ID    string `json:"-" param:"id" validate:"required"`
+	Page  int    `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit int    `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListWebhookDeliveriesResponse represent a response for listing deliveries
+// of a webhook.
+type ListWebhookDeliveriesResponse struct {
+	Items   []entity.WebhookDelivery `json:"items"`
+	HasNext bool                     `json:"has_next"`
+	Page    uint                     `json:"page"`
+	Limit   uint                     `json:"limit"`
+}
+
+// ListWebhookDeliveryAttemptsRequest represent a request to get attempts of a
+// delivery.
+type ListWebhookDeliveryAttemptsRequest struct {
+	ID         string `param:"id" validate:"required"`
+	DeliveryID string `param:"delivery_id" validate:"required"`
+}
+
+// ListWebhookDeliveryAttemptsResponse represent a response for listing
+// attempts of a delivery.
+type ListWebhookDeliveryAttemptsResponse struct {
+	Items []entity.WebhookAttempt `json:"items"`
+}
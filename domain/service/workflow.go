@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// WorkflowService exposes all available use cases of workflow domain.
+type WorkflowService interface {
+	// CreateWorkflow creates a new workflow.
+	CreateWorkflow(ctx context.Context, req *CreateWorkflowRequest) (*CreateWorkflowResponse, error)
+
+	// GetWorkflow finds and returns a specific workflow.
+	GetWorkflow(ctx context.Context, req *GetWorkflowRequest) (*GetWorkflowResponse, error)
+
+	// ListWorkflows finds and returns a list of workflows of a project, or of
+	// the whole tenant.
+	ListWorkflows(ctx context.Context, req *ListWorkflowsRequest) (*ListWorkflowsResponse, error)
+
+	// UpdateWorkflow updates a specific workflow.
+	UpdateWorkflow(ctx context.Context, req *UpdateWorkflowRequest) (*UpdateWorkflowResponse, error)
+
+	// DeleteWorkflow deletes a specific workflow.
+	DeleteWorkflow(ctx context.Context, req *DeleteWorkflowRequest) (*DeleteWorkflowResponse, error)
+}
+
+// WorkflowStatusInput represent a status of workflow in requests. Order of
+// statuses in request is their order in workflow, the first one is status of
+// new tasks.
+type WorkflowStatusInput struct {
+	Key      string `json:"key" validate:"required,max=32"`
+	Name     string `json:"name" validate:"required,max=64"`
+	Category string `json:"category" validate:"required,oneof=open in_progress closed"`
+}
+
+// WorkflowTransitionInput represent an allowed transition of workflow in
+// requests.
+type WorkflowTransitionInput struct {
+	From string `json:"from" validate:"required"`
+	To   string `json:"to" validate:"required"`
+}
+
+// CreateWorkflowRequest represent a request to create a workflow. Workflow
+// is shared by the whole tenant unless a project is given.
+type CreateWorkflowRequest struct {
+	ProjectID   *string                   `json:"project_id"`
+	Name        string                    `json:"name" validate:"required,max=128"`
+	Statuses    []WorkflowStatusInput     `json:"statuses" validate:"required,min=1,dive"`
+	Transitions []WorkflowTransitionInput `json:"transitions" validate:"dive"`
+}
+
+// CreateWorkflowResponse represent a response for creating a workflow.
+type CreateWorkflowResponse struct {
+	Message string `json:"message"`
+}
+
+// GetWorkflowRequest represent a request to get a workflow.
+type GetWorkflowRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// GetWorkflowResponse represent a response for getting a workflow.
+type GetWorkflowResponse struct {
+	*entity.Workflow
+}
+
+// ListWorkflowsRequest represent a request to get a list of workflows of a
+// project, or of the whole tenant if no project is given.
+type ListWorkflowsRequest struct {
+	ProjectID *string `json:"-" query:"project_id" field:"project_id"`
+	Page      int     `json:"-" query:"page" field:"page" validate:"gte=1"`
+	Limit     int     `json:"-" query:"limit" field:"limit" validate:"gte=1"`
+}
+
+// ListWorkflowsResponse represent a response for listing workflows.
+type ListWorkflowsResponse struct {
+	Items   []entity.Workflow `json:"items"`
+	HasNext bool              `json:"has_next"`
+	Page    uint              `json:"page"`
+	Limit   uint              `json:"limit"`
+}
+
+// UpdateWorkflowRequest represent a request to update a workflow. Statuses and
+// transitions are replaced as a whole when statuses are given.
+type UpdateWorkflowRequest struct {
+	ID          string                    `json:"-"  param:"id" validate:"required"`
+	Name        *string                   `json:"name" validate:"omitempty,max=128"`
+	Statuses    []WorkflowStatusInput     `json:"statuses" validate:"omitempty,min=1,dive"`
+	Transitions []WorkflowTransitionInput `json:"transitions" validate:"dive"`
+}
+
+// UpdateWorkflowResponse represent a response for updating a workflow.
+type UpdateWorkflowResponse struct {
+	Message string `json:"message"`
+}
+
+// DeleteWorkflowRequest represent a request to delete a workflow.
+type DeleteWorkflowRequest struct {
+	ID string `param:"id" validate:"required"`
+}
+
+// DeleteWorkflowResponse represent a response for deleting a workflow.
+type DeleteWorkflowResponse struct {
+	Message string `json:"message"`
+}
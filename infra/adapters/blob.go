@@ -0,0 +1,40 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/blob"
+	"github.com/quocdaitrn/cp-task/infra/config"
+	"github.com/quocdaitrn/cp-task/infra/repo/blobimpl"
+)
+
+const (
+	defaultAttachmentStorageDir = "./data/attachments"
+	defaultAttachmentMaxSize    = 10 << 20 // 10 MB
+)
+
+func ProvideBlobStorage(cfg config.Config) (blob.Storage, error) {
+	switch cfg.AttachmentStorageDriver {
+	case "", "local":
+		dir := cfg.AttachmentStorageDir
+		if dir == "" {
+			dir = defaultAttachmentStorageDir
+		}
+		return blobimpl.NewLocalStorage(dir)
+	default:
+		return nil, fmt.Errorf("unsupported attachment storage driver %q", cfg.AttachmentStorageDriver)
+	}
+}
+
+func ProvideAttachmentPolicy(cfg config.Config) entity.AttachmentPolicy {
+	maxSize := cfg.AttachmentMaxSize
+	if maxSize <= 0 {
+		maxSize = defaultAttachmentMaxSize
+	}
+
+	return entity.AttachmentPolicy{
+		MaxSize:      maxSize,
+		AllowedTypes: cfg.AttachmentAllowedTypes,
+	}
+}
@@ -0,0 +1,18 @@
+package adapters
+
+import (
+	"fmt"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/notifier"
+	"github.com/quocdaitrn/cp-task/infra/config"
+	"github.com/quocdaitrn/cp-task/infra/repo/notifierimpl"
+)
+
+func ProvideNotifier(cfg config.Config) (notifier.Notifier, error) {
+	switch cfg.NotifierDriver {
+	case "", "log":
+		return notifierimpl.NewLogNotifier(), nil
+	default:
+		return nil, fmt.Errorf("unsupported notifier driver %q", cfg.NotifierDriver)
+	}
+}
@@ -12,12 +12,39 @@ import (
 	"github.com/quocdaitrn/cp-task/infra/config"
 )
 
-func ProvideRoutes(taskSvc service.TaskService, logger log.Logger, cfg config.Config, authClient auth.AuthenticateClient) RestAPIHandler {
+func ProvideRoutes(
+	taskSvc service.TaskService,
+	labelSvc service.LabelService,
+	workflowSvc service.WorkflowService,
+	projectSvc service.ProjectService,
+	shareLinkSvc service.ShareLinkService,
+	commentSvc service.CommentService,
+	checklistSvc service.ChecklistService,
+	attachmentSvc service.AttachmentService,
+	reminderSvc service.ReminderService,
+	notificationSvc service.NotificationService,
+	webhookSvc service.WebhookService,
+	tenantSvc service.TenantService,
+	logger log.Logger,
+	cfg config.Config,
+	authClient auth.AuthenticateClient,
+) RestAPIHandler {
 	r := mux.NewRouter()
 	handler.MakeAppHandler(r, logger, cfg)
 
 	v1 := r.PathPrefix("/v1").Subrouter()
 	handler.MakeTaskHTTPHandler(v1, taskSvc, logger, authClient)
+	handler.MakeLabelHTTPHandler(v1, labelSvc, logger, authClient)
+	handler.MakeWorkflowHTTPHandler(v1, workflowSvc, logger, authClient)
+	handler.MakeProjectHTTPHandler(v1, projectSvc, logger, authClient)
+	handler.MakeShareLinkHTTPHandler(v1, shareLinkSvc, logger, authClient)
+	handler.MakeCommentHTTPHandler(v1, commentSvc, logger, authClient)
+	handler.MakeChecklistHTTPHandler(v1, checklistSvc, logger, authClient)
+	handler.MakeAttachmentHTTPHandler(v1, attachmentSvc, logger, authClient)
+	handler.MakeReminderHTTPHandler(v1, reminderSvc, logger, authClient)
+	handler.MakeNotificationHTTPHandler(v1, notificationSvc, logger, authClient)
+	handler.MakeWebhookHTTPHandler(v1, webhookSvc, logger, authClient)
+	handler.MakeTenantHTTPHandler(v1, tenantSvc, logger, authClient)
 
 	return setupCORSMiddleware(r)
 }
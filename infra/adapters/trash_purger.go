@@ -0,0 +1,142 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/blob"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+	"github.com/quocdaitrn/cp-task/infra/config"
+)
+
+const (
+	defaultTrashRetentionDays = 30
+	defaultTrashPurgeInterval = time.Hour
+
+	// trashPurgeBatchSize is the number of tasks purged in one transaction.
+	trashPurgeBatchSize = 100
+)
+
+// TrashPurger periodically purges tasks which have stayed in trash longer than
+// the retention period.
+type TrashPurger struct {
+	taskRepo       store.TaskRepo
+	attachmentRepo store.AttachmentRepo
+	blobStorage    blob.Storage
+	retention      time.Duration
+	interval       time.Duration
+
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// Start runs purging in background until the purger is closed. Tasks are
+// purged once right away, then every interval.
+func (p *TrashPurger) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.started = true
+	p.cancel = cancel
+
+	go func() {
+		defer close(p.done)
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			p.purge(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (p *TrashPurger) Close() {
+	if p.started {
+		p.cancel()
+		<-p.done
+		logrus.Info("The trash purger was stopped")
+	}
+}
+
+// purge permanently deletes tasks moved to trash before the retention period,
+// along with files of their attachments.
+func (p *TrashPurger) purge(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+	purged := 0
+
+	// Purge in batches to keep transactions short
+	for ctx.Err() == nil {
+		ids, err := p.taskRepo.FindDeletedBefore(ctx, before, trashPurgeBatchSize)
+		if err != nil {
+			logrus.Errorf("Can not find trashed tasks, error: %s", err.Error())
+			break
+		}
+
+		if len(ids) == 0 {
+			break
+		}
+
+		attachments, err := p.attachmentRepo.FindByTaskIDs(ctx, ids)
+		if err != nil {
+			logrus.Errorf("Can not find attachments of trashed tasks, error: %s", err.Error())
+			break
+		}
+
+		if err := p.taskRepo.PurgeMany(ctx, ids); err != nil {
+			logrus.Errorf("Can not purge trashed tasks, error: %s", err.Error())
+			break
+		}
+		purged += len(ids)
+
+		// Files are removed once their metadata is gone, a failure only
+		// leaves an unreachable file behind
+		for _, a := range attachments {
+			if err := p.blobStorage.Delete(ctx, a.StorageKey); err != nil {
+				logrus.Errorf("Can not delete file of attachment %d, error: %s", a.ID, err.Error())
+			}
+		}
+	}
+
+	if purged > 0 {
+		logrus.Infof("Purged %d trashed tasks", purged)
+	}
+}
+
+func ProvideTrashPurger(
+	cfg config.Config,
+	taskRepo store.TaskRepo,
+	attachmentRepo store.AttachmentRepo,
+	blobStorage blob.Storage,
+) (*TrashPurger, func(), error) {
+	retentionDays := cfg.TrashRetentionDays
+	if retentionDays <= 0 {
+		retentionDays = defaultTrashRetentionDays
+	}
+
+	interval := cfg.TrashPurgeInterval
+	if interval <= 0 {
+		interval = defaultTrashPurgeInterval
+	}
+
+	purger := &TrashPurger{
+		taskRepo:       taskRepo,
+		attachmentRepo: attachmentRepo,
+		blobStorage:    blobStorage,
+		retention:      time.Duration(retentionDays) * 24 * time.Hour,
+		interval:       interval,
+		done:           make(chan struct{}),
+	}
+	logrus.Infof("Init trash purger, retention %d days, interval %s", retentionDays, interval)
+	return purger, func() {
+		logrus.Info("Cleanup trash purger")
+		purger.Close()
+	}, nil
+}
@@ -0,0 +1,20 @@
+package adapters
+
+import (
+	"time"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/webhook"
+	"github.com/quocdaitrn/cp-task/infra/config"
+	"github.com/quocdaitrn/cp-task/infra/repo/webhookimpl"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+func ProvideWebhookSender(cfg config.Config) webhook.Sender {
+	timeout := cfg.WebhookTimeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	return webhookimpl.NewHTTPSender(timeout, cfg.WebhookAllowLoopback)
+}
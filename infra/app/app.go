@@ -10,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
 	"github.com/quocdaitrn/cp-task/infra/config"
 )
 
@@ -17,13 +18,19 @@ type ApplicationContext struct {
 	ctx context.Context
 	cfg config.Config
 
-	restService *adapters.RestService
+	roleBindingRepo     store.RoleBindingRepo
+	restService         *adapters.RestService
+	trashPurger         *adapters.TrashPurger
+	recurrenceScheduler *RecurrenceScheduler
+	reminderScheduler   *ReminderScheduler
+	webhookScheduler    *WebhookScheduler
 }
 
 func (a *ApplicationContext) Commands() *cli.App {
 	app := cli.NewApp()
 	app.Commands = []*cli.Command{
 		a.Serve(),
+		a.GrantTenantRole(),
 	}
 	return app
 }
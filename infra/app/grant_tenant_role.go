@@ -0,0 +1,52 @@
+package app
+
+import (
+	"fmt"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"github.com/viettranx/service-context/core"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// GrantTenantRole grants a role on whole tenant to an user from command line.
+// Tenant admins grant roles through the API, this command gives a tenant its
+// first admin.
+func (a *ApplicationContext) GrantTenantRole() *cli.Command {
+	return &cli.Command{
+		Name:        "grant-tenant-role",
+		UsageText:   "grant-tenant-role --tenant <tenant id> --user <user id> [--role admin]",
+		Description: "Command to grant a role on whole tenant to an user",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "tenant", Usage: "id of the tenant", Required: true},
+			&cli.StringFlag{Name: "user", Usage: "masked id of the user", Required: true},
+			&cli.StringFlag{Name: "role", Usage: "viewer, editor or admin", Value: string(entity.RoleAdmin)},
+		},
+		Action: func(c *cli.Context) error {
+			role := entity.Role(c.String("role"))
+			if !role.Includes(entity.RoleViewer) {
+				return fmt.Errorf("unknown role %q", role)
+			}
+
+			userUID, err := core.FromBase58(c.String("user"))
+			if err != nil {
+				return fmt.Errorf("invalid user id: %w", err)
+			}
+
+			ctx := kitcontext.WithUID(a.ctx, kitcontext.UID{Tid: c.String("tenant")})
+
+			if err := a.roleBindingRepo.UpsertOne(ctx, &entity.RoleBinding{
+				UserID: uint(userUID.GetLocalID()),
+				Role:   role,
+			}); err != nil {
+				return err
+			}
+
+			logrus.Infof("Granted %s role on tenant %s to user %s", role, c.String("tenant"), c.String("user"))
+
+			return nil
+		},
+	}
+}
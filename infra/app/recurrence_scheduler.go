@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+	"github.com/quocdaitrn/cp-task/infra/config"
+)
+
+const (
+	defaultRecurrenceLeadTime         = 24 * time.Hour
+	defaultRecurrenceScheduleInterval = 5 * time.Minute
+)
+
+// RecurrenceScheduler periodically creates next occurrences of recurring tasks
+// ahead of their time, so they show up before they are due even if the
+// current occurrence is not completed yet. Each occurrence is only created
+// once, so restarting the scheduler or running several instances of it is
+// safe.
+type RecurrenceScheduler struct {
+	recurrenceService service.RecurrenceService
+	leadTime          time.Duration
+	interval          time.Duration
+
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// Start runs scheduling in background until the scheduler is closed.
+// Occurrences are created once right away, then every interval.
+func (s *RecurrenceScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.started = true
+	s.cancel = cancel
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.schedule(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *RecurrenceScheduler) Close() {
+	if s.started {
+		s.cancel()
+		<-s.done
+		logrus.Info("The recurrence scheduler was stopped")
+	}
+}
+
+// schedule creates occurrences which are due within the lead time.
+func (s *RecurrenceScheduler) schedule(ctx context.Context) {
+	created, err := s.recurrenceService.CreateDueOccurrences(ctx, time.Now().Add(s.leadTime))
+	if err != nil {
+		logrus.Errorf("Can not create occurrences of recurring tasks, error: %s", err.Error())
+	}
+
+	if created > 0 {
+		logrus.Infof("Created %d occurrences of recurring tasks", created)
+	}
+}
+
+func ProvideRecurrenceScheduler(
+	cfg config.Config,
+	recurrenceService service.RecurrenceService,
+) (*RecurrenceScheduler, func(), error) {
+	leadTime := cfg.RecurrenceLeadTime
+	if leadTime <= 0 {
+		leadTime = defaultRecurrenceLeadTime
+	}
+
+	interval := cfg.RecurrenceScheduleInterval
+	if interval <= 0 {
+		interval = defaultRecurrenceScheduleInterval
+	}
+
+	scheduler := &RecurrenceScheduler{
+		recurrenceService: recurrenceService,
+		leadTime:          leadTime,
+		interval:          interval,
+		done:              make(chan struct{}),
+	}
+	logrus.Infof("Init recurrence scheduler, lead time %s, interval %s", leadTime, interval)
+	return scheduler, func() {
+		logrus.Info("Cleanup recurrence scheduler")
+		scheduler.Close()
+	}, nil
+}
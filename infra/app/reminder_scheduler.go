@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+	"github.com/quocdaitrn/cp-task/infra/config"
+)
+
+const defaultReminderScheduleInterval = time.Minute
+
+// ReminderScheduler periodically fires due reminders. Reminders are stored in
+// database and marked once fired, so each of them fires once across restarts
+// and several instances of the scheduler.
+type ReminderScheduler struct {
+	reminderService service.ReminderService
+	interval        time.Duration
+
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// Start runs scheduling in background until the scheduler is closed. Due
+// reminders are fired once right away, so the ones missed while the process
+// was down go out first, then every interval.
+func (s *ReminderScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.started = true
+	s.cancel = cancel
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.fire(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *ReminderScheduler) Close() {
+	if s.started {
+		s.cancel()
+		<-s.done
+		logrus.Info("The reminder scheduler was stopped")
+	}
+}
+
+// fire fires reminders which are due now.
+func (s *ReminderScheduler) fire(ctx context.Context) {
+	fired, err := s.reminderService.FireDueReminders(ctx, time.Now())
+	if err != nil {
+		logrus.Errorf("Can not fire due reminders, error: %s", err.Error())
+	}
+
+	if fired > 0 {
+		logrus.Infof("Fired %d reminders", fired)
+	}
+}
+
+func ProvideReminderScheduler(
+	cfg config.Config,
+	reminderService service.ReminderService,
+) (*ReminderScheduler, func(), error) {
+	interval := cfg.ReminderScheduleInterval
+	if interval <= 0 {
+		interval = defaultReminderScheduleInterval
+	}
+
+	scheduler := &ReminderScheduler{
+		reminderService: reminderService,
+		interval:        interval,
+		done:            make(chan struct{}),
+	}
+	logrus.Infof("Init reminder scheduler, interval %s", interval)
+	return scheduler, func() {
+		logrus.Info("Cleanup reminder scheduler")
+		scheduler.Close()
+	}, nil
+}
@@ -8,6 +8,10 @@ func (a *ApplicationContext) Serve() *cli.Command {
 		UsageText:   "Start REST API",
 		Description: "Command to start REST API service",
 		Action: func(ctx *cli.Context) error {
+			a.trashPurger.Start()
+			a.recurrenceScheduler.Start()
+			a.reminderScheduler.Start()
+			a.webhookScheduler.Start()
 			a.restService.MustStart()
 			return nil
 		},
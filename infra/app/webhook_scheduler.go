@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quocdaitrn/cp-task/domain/service"
+	"github.com/quocdaitrn/cp-task/infra/config"
+)
+
+const defaultWebhookScheduleInterval = 30 * time.Second
+
+// WebhookScheduler periodically attempts due webhook deliveries. Deliveries
+// are stored in database along with their next attempt, so retries survive
+// restarts and each attempt is made by one instance of the scheduler.
+type WebhookScheduler struct {
+	webhookService service.WebhookService
+	interval       time.Duration
+
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// Start runs scheduling in background until the scheduler is closed. Due
+// deliveries are attempted once right away, so the ones queued while the
+// process was down go out first, then every interval.
+func (s *WebhookScheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.started = true
+	s.cancel = cancel
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.deliver(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *WebhookScheduler) Close() {
+	if s.started {
+		s.cancel()
+		<-s.done
+		logrus.Info("The webhook scheduler was stopped")
+	}
+}
+
+// deliver attempts deliveries which are due now.
+func (s *WebhookScheduler) deliver(ctx context.Context) {
+	attempted, err := s.webhookService.DeliverDueWebhooks(ctx, time.Now())
+	if err != nil {
+		logrus.Errorf("Can not deliver due webhooks, error: %s", err.Error())
+	}
+
+	if attempted > 0 {
+		logrus.Infof("Attempted %d webhook deliveries", attempted)
+	}
+}
+
+func ProvideWebhookScheduler(
+	cfg config.Config,
+	webhookService service.WebhookService,
+) (*WebhookScheduler, func(), error) {
+	interval := cfg.WebhookScheduleInterval
+	if interval <= 0 {
+		interval = defaultWebhookScheduleInterval
+	}
+
+	scheduler := &WebhookScheduler{
+		webhookService: webhookService,
+		interval:       interval,
+		done:           make(chan struct{}),
+	}
+	logrus.Infof("Init webhook scheduler, interval %s", interval)
+	return scheduler, func() {
+		logrus.Info("Cleanup webhook scheduler")
+		scheduler.Close()
+	}, nil
+}
@@ -29,6 +29,24 @@ func InitApplication(ctx context.Context) (*ApplicationContext, func(), error) {
 		return nil, nil, err
 	}
 	taskRepo := storeimpl.NewTaskRepo(db)
+	labelRepo := storeimpl.NewLabelRepo(db)
+	taskAssigneeRepo := storeimpl.NewTaskAssigneeRepo(db)
+	taskDependencyRepo := storeimpl.NewTaskDependencyRepo(db)
+	workflowRepo := storeimpl.NewWorkflowRepo(db)
+	projectRepo := storeimpl.NewProjectRepo(db)
+	roleBindingRepo := storeimpl.NewRoleBindingRepo(db)
+	taskShareRepo := storeimpl.NewTaskShareRepo(db)
+	taskWatcherRepo := storeimpl.NewTaskWatcherRepo(db)
+	shareLinkRepo := storeimpl.NewShareLinkRepo(db)
+	commentRepo := storeimpl.NewCommentRepo(db)
+	mentionRepo := storeimpl.NewMentionRepo(db)
+	activityRepo := storeimpl.NewActivityRepo(db)
+	checklistItemRepo := storeimpl.NewChecklistItemRepo(db)
+	attachmentRepo := storeimpl.NewAttachmentRepo(db)
+	reminderRepo := storeimpl.NewReminderRepo(db)
+	notificationRepo := storeimpl.NewNotificationRepo(db)
+	webhookRepo := storeimpl.NewWebhookRepo(db)
+	authorizer := serviceimpl.NewRoleAuthorizer(roleBindingRepo, projectRepo, taskAssigneeRepo, taskShareRepo)
 	userServiceClient, err := adapters.ProvideGRPCUserServiceClient(configConfig)
 	if err != nil {
 		return nil, nil, err
@@ -38,23 +56,80 @@ func InitApplication(ctx context.Context) (*ApplicationContext, func(), error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	taskService := serviceimpl.NewTaskService(taskRepo, userRepo, validatorValidator)
+	storage, err := adapters.ProvideBlobStorage(configConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	recurrenceService := serviceimpl.NewRecurrenceService(taskRepo, workflowRepo, activityRepo)
+	notifierNotifier, err := adapters.ProvideNotifier(configConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	notificationService := serviceimpl.NewNotificationService(notificationRepo, taskAssigneeRepo, taskWatcherRepo, notifierNotifier, authorizer, userRepo, validatorValidator)
+	webhookSender := adapters.ProvideWebhookSender(configConfig)
+	webhookService := serviceimpl.NewWebhookService(webhookRepo, taskWatcherRepo, webhookSender, authorizer, validatorValidator)
+	taskService := serviceimpl.NewTaskService(taskRepo, labelRepo, taskAssigneeRepo, taskDependencyRepo, taskShareRepo, taskWatcherRepo, mentionRepo, activityRepo, checklistItemRepo, attachmentRepo, reminderRepo, storage, workflowRepo, projectRepo, authorizer, recurrenceService, notificationService, webhookService, userRepo, validatorValidator)
+	labelService := serviceimpl.NewLabelService(labelRepo, validatorValidator)
+	workflowService := serviceimpl.NewWorkflowService(workflowRepo, taskRepo, projectRepo, authorizer, validatorValidator)
+	projectService := serviceimpl.NewProjectService(projectRepo, workflowRepo, roleBindingRepo, authorizer, userRepo, validatorValidator)
+	shareLinkService := serviceimpl.NewShareLinkService(shareLinkRepo, taskRepo, authorizer, validatorValidator)
+	commentService := serviceimpl.NewCommentService(commentRepo, mentionRepo, taskWatcherRepo, taskRepo, authorizer, notificationService, userRepo, validatorValidator)
+	checklistService := serviceimpl.NewChecklistService(checklistItemRepo, taskRepo, authorizer, validatorValidator)
+	attachmentPolicy := adapters.ProvideAttachmentPolicy(configConfig)
+	attachmentService := serviceimpl.NewAttachmentService(attachmentRepo, taskRepo, storage, attachmentPolicy, authorizer, userRepo, validatorValidator)
+	reminderService := serviceimpl.NewReminderService(reminderRepo, taskRepo, notificationService, authorizer, validatorValidator)
+	tenantService := serviceimpl.NewTenantService(roleBindingRepo, authorizer, userRepo, validatorValidator)
 	logger := providers.ProvideLogger()
 	authenticateClient, err := adapters.ProvideGRPCAuthClient(configConfig)
 	if err != nil {
 		return nil, nil, err
 	}
-	restAPIHandler := adapters.ProvideRoutes(taskService, logger, configConfig, authenticateClient)
+	restAPIHandler := adapters.ProvideRoutes(taskService, labelService, workflowService, projectService, shareLinkService, commentService, checklistService, attachmentService, reminderService, notificationService, webhookService, tenantService, logger, configConfig, authenticateClient)
 	restService, cleanup, err := adapters.ProvideRestService(configConfig, restAPIHandler)
 	if err != nil {
 		return nil, nil, err
 	}
+	trashPurger, cleanup2, err := adapters.ProvideTrashPurger(configConfig, taskRepo, attachmentRepo, storage)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	recurrenceScheduler, cleanup3, err := ProvideRecurrenceScheduler(configConfig, recurrenceService)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	reminderScheduler, cleanup4, err := ProvideReminderScheduler(configConfig, reminderService)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhookScheduler, cleanup5, err := ProvideWebhookScheduler(configConfig, webhookService)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	applicationContext := &ApplicationContext{
-		ctx:         ctx,
-		cfg:         configConfig,
-		restService: restService,
+		ctx:                 ctx,
+		cfg:                 configConfig,
+		roleBindingRepo:     roleBindingRepo,
+		restService:         restService,
+		trashPurger:         trashPurger,
+		recurrenceScheduler: recurrenceScheduler,
+		reminderScheduler:   reminderScheduler,
+		webhookScheduler:    webhookScheduler,
 	}
 	return applicationContext, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -19,11 +19,49 @@ var ApplicationSet = wire.NewSet(
 	adapters.ProvideMySQL,
 	adapters.ProvideRoutes,
 	adapters.ProvideRestService,
+	adapters.ProvideTrashPurger,
+	ProvideRecurrenceScheduler,
+	ProvideReminderScheduler,
+	ProvideWebhookScheduler,
+	adapters.ProvideNotifier,
+	adapters.ProvideWebhookSender,
+	adapters.ProvideBlobStorage,
+	adapters.ProvideAttachmentPolicy,
 	providers.ProvideLogger,
 	adapters.ProvideGRPCAuthClient,
 	adapters.ProvideGRPCUserServiceClient,
 
 	storeimpl.NewTaskRepo,
+	storeimpl.NewLabelRepo,
+	storeimpl.NewTaskAssigneeRepo,
+	storeimpl.NewTaskDependencyRepo,
+	storeimpl.NewWorkflowRepo,
+	storeimpl.NewProjectRepo,
+	storeimpl.NewRoleBindingRepo,
+	storeimpl.NewTaskShareRepo,
+	storeimpl.NewTaskWatcherRepo,
+	storeimpl.NewShareLinkRepo,
+	storeimpl.NewCommentRepo,
+	storeimpl.NewMentionRepo,
+	storeimpl.NewActivityRepo,
+	storeimpl.NewChecklistItemRepo,
+	storeimpl.NewAttachmentRepo,
+	storeimpl.NewReminderRepo,
+	storeimpl.NewNotificationRepo,
+	storeimpl.NewWebhookRepo,
 	rpcimpl.NewUserRepo,
+	serviceimpl.NewRoleAuthorizer,
+	serviceimpl.NewRecurrenceService,
 	serviceimpl.NewTaskService,
+	serviceimpl.NewLabelService,
+	serviceimpl.NewWorkflowService,
+	serviceimpl.NewProjectService,
+	serviceimpl.NewShareLinkService,
+	serviceimpl.NewCommentService,
+	serviceimpl.NewChecklistService,
+	serviceimpl.NewAttachmentService,
+	serviceimpl.NewReminderService,
+	serviceimpl.NewNotificationService,
+	serviceimpl.NewWebhookService,
+	serviceimpl.NewTenantService,
 )
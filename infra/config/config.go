@@ -1,20 +1,35 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	ServiceName                  string `mapstructure:"SERVICE_NAME"`
-	ServiceVersion               string `mapstructure:"SERVICE_VERSION"`
-	Environment                  string `mapstructure:"ENVIRONMENT"`
-	DBDriver                     string `mapstructure:"DB_DRIVER"`
-	DBDsn                        string `mapstructure:"DB_DSN"`
-	HTTPServerPort               int    `mapstructure:"HTTP_SERVER_PORT"`
-	GRPCServerAuthServiceAddress string `mapstructure:"GRPC_SERVER_AUTH_SERVICE_ADDRESS"`
-	GRPCServerUserServiceAddress string `mapstructure:"GRPC_SERVER_USER_SERVICE_ADDRESS"`
+	ServiceName                  string        `mapstructure:"SERVICE_NAME"`
+	ServiceVersion               string        `mapstructure:"SERVICE_VERSION"`
+	Environment                  string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                     string        `mapstructure:"DB_DRIVER"`
+	DBDsn                        string        `mapstructure:"DB_DSN"`
+	HTTPServerPort               int           `mapstructure:"HTTP_SERVER_PORT"`
+	GRPCServerAuthServiceAddress string        `mapstructure:"GRPC_SERVER_AUTH_SERVICE_ADDRESS"`
+	GRPCServerUserServiceAddress string        `mapstructure:"GRPC_SERVER_USER_SERVICE_ADDRESS"`
+	TrashRetentionDays           int           `mapstructure:"TRASH_RETENTION_DAYS"`
+	TrashPurgeInterval           time.Duration `mapstructure:"TRASH_PURGE_INTERVAL"`
+	AttachmentStorageDriver      string        `mapstructure:"ATTACHMENT_STORAGE_DRIVER"`
+	AttachmentStorageDir         string        `mapstructure:"ATTACHMENT_STORAGE_DIR"`
+	AttachmentMaxSize            int64         `mapstructure:"ATTACHMENT_MAX_SIZE"`
+	AttachmentAllowedTypes       []string      `mapstructure:"ATTACHMENT_ALLOWED_TYPES"`
+	RecurrenceLeadTime           time.Duration `mapstructure:"RECURRENCE_LEAD_TIME"`
+	RecurrenceScheduleInterval   time.Duration `mapstructure:"RECURRENCE_SCHEDULE_INTERVAL"`
+	ReminderScheduleInterval     time.Duration `mapstructure:"REMINDER_SCHEDULE_INTERVAL"`
+	NotifierDriver               string        `mapstructure:"NOTIFIER_DRIVER"`
+	WebhookTimeout               time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	WebhookScheduleInterval      time.Duration `mapstructure:"WEBHOOK_SCHEDULE_INTERVAL"`
+	WebhookAllowLoopback         bool          `mapstructure:"WEBHOOK_ALLOW_LOOPBACK"`
 }
 
 // ProvideConfig reads configuration from file or environment variables.
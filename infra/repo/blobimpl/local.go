@@ -0,0 +1,110 @@
+package blobimpl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/blob"
+)
+
+// localStorage implements blob storage on local filesystem. It is meant for
+// development and tests, objects are files under the root directory.
+type localStorage struct {
+	root string
+}
+
+// NewLocalStorage creates and returns a new instance of Storage keeping
+// objects under root directory, which is created if missing.
+func NewLocalStorage(root string) (blob.Storage, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &localStorage{root: root}, nil
+}
+
+// Put stores content read from r under key.
+func (s *localStorage) Put(_ context.Context, key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	// Write to a temporary file first, so a failed upload never leaves a
+	// partial object behind
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return n, errors.WithStack(err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return n, errors.WithStack(err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return n, errors.WithStack(err)
+	}
+
+	return n, nil
+}
+
+// Get opens the object stored under key for reading.
+func (s *localStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, blob.ErrObjectNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return f, nil
+}
+
+// Delete removes the object stored under key.
+func (s *localStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// path maps key to a file path, refusing keys which would escape the root
+// directory.
+func (s *localStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if cleaned == "." || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.root, cleaned), nil
+}
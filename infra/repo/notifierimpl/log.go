@@ -0,0 +1,72 @@
+package notifierimpl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/notifier"
+)
+
+// sentKeysLimit is the number of keys of sent messages a log notifier
+// remembers to leave out repeated messages.
+const sentKeysLimit = 10000
+
+// logNotifier implements notifier by writing messages to the log. It is meant
+// for development and tests.
+type logNotifier struct {
+	mu sync.Mutex
+	// sent holds keys of recently sent messages, and keys lists them oldest
+	// first so the oldest one is forgotten once limit is reached.
+	sent map[string]bool
+	keys []string
+}
+
+// NewLogNotifier creates and returns a new instance of Notifier writing
+// messages to the log.
+func NewLogNotifier() notifier.Notifier {
+	return &logNotifier{sent: make(map[string]bool)}
+}
+
+// Notify delivers a message to its user. A message whose key was already
+// delivered by this process is acknowledged without being logged again.
+func (n *logNotifier) Notify(_ context.Context, msg *notifier.Message) error {
+	if !n.remember(msg.Key) {
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"key":       msg.Key,
+		"tenant_id": msg.TenantID,
+		"user_id":   msg.UserID,
+		"task_id":   msg.TaskID,
+	}).Infof("Notify: %s - %s", msg.Title, msg.Body)
+
+	return nil
+}
+
+// remember records key as sent and reports whether it was not sent before.
+// Messages without key are always sent.
+func (n *logNotifier) remember(key string) bool {
+	if key == "" {
+		return true
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.sent[key] {
+		return false
+	}
+
+	if len(n.keys) >= sentKeysLimit {
+		delete(n.sent, n.keys[0])
+		n.keys = n.keys[1:]
+	}
+
+	n.sent[key] = true
+	n.keys = append(n.keys, key)
+
+	return true
+}
@@ -0,0 +1,60 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// activityRepo implements methods of activity's repository.
+type activityRepo struct {
+	db *gorm.DB
+}
+
+// NewActivityRepo creates and returns a new instance of ActivityRepo.
+func NewActivityRepo(db *gorm.DB) store.ActivityRepo {
+	return &activityRepo{db: db}
+}
+
+// InsertMany appends activities to database.
+func (r *activityRepo) InsertMany(_ context.Context, activities []entity.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	if err := r.db.Create(&activities).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindRangeByTaskID fetches list of activities of a task, newest first.
+func (r *activityRepo) FindRangeByTaskID(_ context.Context, taskID uint, paging *core.Paging) ([]entity.Activity, error) {
+	var activities []entity.Activity
+
+	db := r.db.
+		Table(entity.Activity{}.TableName()).
+		Where("task_id = ?", taskID)
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id desc").
+		Find(&activities).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,78 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// attachmentRepo implements methods of attachment's repository.
+type attachmentRepo struct {
+	db *gorm.DB
+}
+
+// NewAttachmentRepo creates and returns a new instance of AttachmentRepo.
+func NewAttachmentRepo(db *gorm.DB) store.AttachmentRepo {
+	return &attachmentRepo{db: db}
+}
+
+// InsertOne inserts an attachment to database.
+func (r *attachmentRepo) InsertOne(_ context.Context, attachment *entity.Attachment) error {
+	if err := r.db.Create(attachment).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes an attachment from database.
+func (r *attachmentRepo) DeleteOne(_ context.Context, id uint) error {
+	if err := r.db.
+		Where("id = ?", id).
+		Delete(&entity.Attachment{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches an attachment from database by id.
+func (r *attachmentRepo) FindOne(_ context.Context, id uint) (*entity.Attachment, error) {
+	var data entity.Attachment
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindByTaskIDs fetches all attachments of list of tasks, oldest first.
+func (r *attachmentRepo) FindByTaskIDs(_ context.Context, taskIDs []uint) ([]entity.Attachment, error) {
+	var attachments []entity.Attachment
+	if len(taskIDs) == 0 {
+		return attachments, nil
+	}
+
+	if err := r.db.
+		Table(entity.Attachment{}.TableName()).
+		Where("task_id IN ?", taskIDs).
+		Order("id asc").
+		Find(&attachments).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return attachments, nil
+}
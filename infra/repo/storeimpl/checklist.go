@@ -0,0 +1,157 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// checklistItemRepo implements methods of checklist item's repository.
+type checklistItemRepo struct {
+	db *gorm.DB
+}
+
+// NewChecklistItemRepo creates and returns a new instance of
+// ChecklistItemRepo.
+func NewChecklistItemRepo(db *gorm.DB) store.ChecklistItemRepo {
+	return &checklistItemRepo{db: db}
+}
+
+// InsertOne inserts an item at the end of the checklist of its task.
+func (r *checklistItemRepo) InsertOne(_ context.Context, item *entity.ChecklistItem) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var last *int
+		if err := tx.Table(item.TableName()).
+			Select("MAX(position)").
+			Where("task_id = ?", item.TaskID).
+			Scan(&last).Error; err != nil {
+			return err
+		}
+
+		item.Position = 0
+		if last != nil {
+			item.Position = *last + 1
+		}
+
+		return tx.Create(item).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// UpdateOne updates an item to database.
+func (r *checklistItemRepo) UpdateOne(_ context.Context, item *entity.ChecklistItem) error {
+	if err := r.db.Table(item.TableName()).
+		Where("id = ?", item.ID).
+		Updates(map[string]interface{}{
+			"text":    item.Text,
+			"checked": item.Checked,
+		}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes an item from database.
+func (r *checklistItemRepo) DeleteOne(_ context.Context, id uint) error {
+	if err := r.db.
+		Where("id = ?", id).
+		Delete(&entity.ChecklistItem{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches an item from database by id.
+func (r *checklistItemRepo) FindOne(_ context.Context, id uint) (*entity.ChecklistItem, error) {
+	var data entity.ChecklistItem
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindByTaskID fetches all items of the checklist of a task, ordered by
+// position.
+func (r *checklistItemRepo) FindByTaskID(_ context.Context, taskID uint) ([]entity.ChecklistItem, error) {
+	var items []entity.ChecklistItem
+
+	if err := r.db.
+		Table(entity.ChecklistItem{}.TableName()).
+		Where("task_id = ?", taskID).
+		Order("position asc, id asc").
+		Find(&items).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return items, nil
+}
+
+// Reorder sets positions of items of a task following the order of ids.
+func (r *checklistItemRepo) Reorder(_ context.Context, taskID uint, ids []uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for position, id := range ids {
+			if err := tx.Table(entity.ChecklistItem{}.TableName()).
+				Where("id = ? AND task_id = ?", id, taskID).
+				UpdateColumn("position", position).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// CountByTaskIDs counts total and checked items of list of tasks, grouped by
+// task id.
+func (r *checklistItemRepo) CountByTaskIDs(_ context.Context, taskIDs []uint) (map[uint]entity.ChecklistStats, error) {
+	result := make(map[uint]entity.ChecklistStats)
+	if len(taskIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		TaskID  uint  `gorm:"column:task_id"`
+		Total   int64 `gorm:"column:total"`
+		Checked int64 `gorm:"column:checked"`
+	}
+
+	if err := r.db.
+		Table(entity.ChecklistItem{}.TableName()).
+		Select("task_id, COUNT(*) AS total, SUM(CASE WHEN checked THEN 1 ELSE 0 END) AS checked").
+		Where("task_id IN ?", taskIDs).
+		Group("task_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = entity.ChecklistStats{Total: row.Total, Checked: row.Checked}
+	}
+
+	return result, nil
+}
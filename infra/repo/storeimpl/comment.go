@@ -0,0 +1,139 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// commentRepo implements methods of comment's repository.
+type commentRepo struct {
+	db *gorm.DB
+}
+
+// NewCommentRepo creates and returns a new instance of CommentRepo.
+func NewCommentRepo(db *gorm.DB) store.CommentRepo {
+	return &commentRepo{db: db}
+}
+
+// InsertOne inserts a comment to database.
+func (r *commentRepo) InsertOne(_ context.Context, comment *entity.Comment) error {
+	if err := r.db.Create(comment).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// UpdateOne updates a comment to database.
+func (r *commentRepo) UpdateOne(_ context.Context, comment *entity.Comment) error {
+	if err := r.db.Table(comment.TableName()).
+		Where("id = ?", comment.ID).
+		Updates(map[string]interface{}{
+			"body": comment.Body,
+		}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a comment along with its replies and their mentions from
+// database.
+func (r *commentRepo) DeleteOne(_ context.Context, id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		comments := tx.
+			Table(entity.Comment{}.TableName()).
+			Select("id").
+			Where("id = ? OR parent_id = ?", id, id)
+
+		if err := tx.
+			Where("comment_id IN (?)", comments).
+			Delete(&entity.Mention{}).Error; err != nil {
+			return err
+		}
+
+		return tx.
+			Where("id = ? OR parent_id = ?", id, id).
+			Delete(&entity.Comment{}).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches a comment from database by id.
+func (r *commentRepo) FindOne(_ context.Context, id uint) (*entity.Comment, error) {
+	var data entity.Comment
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindRangeByTaskID fetches list of root comments of a task, oldest first.
+func (r *commentRepo) FindRangeByTaskID(_ context.Context, taskID uint, paging *core.Paging) ([]entity.Comment, error) {
+	var comments []entity.Comment
+
+	db := r.db.
+		Table(entity.Comment{}.TableName()).
+		Where("task_id = ? AND parent_id IS NULL", taskID)
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id asc").
+		Find(&comments).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return comments, nil
+}
+
+// FindReplies fetches replies of list of comments, grouped by parent comment
+// id.
+func (r *commentRepo) FindReplies(_ context.Context, parentIDs []uint) (map[uint][]entity.Comment, error) {
+	result := make(map[uint][]entity.Comment)
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	var replies []entity.Comment
+
+	if err := r.db.
+		Table(entity.Comment{}.TableName()).
+		Where("parent_id IN ?", parentIDs).
+		Order("id asc").
+		Find(&replies).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, c := range replies {
+		result[*c.ParentID] = append(result[*c.ParentID], c)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,177 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// labelRepo implements methods of label's repository.
+type labelRepo struct {
+	db *gorm.DB
+}
+
+// NewLabelRepo creates and returns a new instance of LabelRepo.
+func NewLabelRepo(db *gorm.DB) store.LabelRepo {
+	return &labelRepo{db: db}
+}
+
+// InsertOne inserts a label to database.
+func (r *labelRepo) InsertOne(_ context.Context, label *entity.Label) error {
+	if err := r.db.Create(label).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// UpdateOne updates a label to database.
+func (r *labelRepo) UpdateOne(_ context.Context, label *entity.Label) error {
+	if err := r.db.Table(label.TableName()).Where("id = ?", label.ID).Updates(label).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a label and all its task associations from database.
+func (r *labelRepo) DeleteOne(_ context.Context, id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("label_id = ?", id).Delete(&entity.TaskLabel{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", id).Delete(&entity.Label{}).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches a label from database by id.
+func (r *labelRepo) FindOne(_ context.Context, id uint) (*entity.Label, error) {
+	var data entity.Label
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindByIDs fetches list of labels by list of ids.
+func (r *labelRepo) FindByIDs(_ context.Context, ids []uint) ([]entity.Label, error) {
+	var labels []entity.Label
+
+	if err := r.db.
+		Table(entity.Label{}.TableName()).
+		Where("id IN ?", ids).
+		Find(&labels).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return labels, nil
+}
+
+// FindRangeByCriteria fetches list of labels by criteria.
+func (r *labelRepo) FindRangeByCriteria(_ context.Context, filter *entity.LabelFilter, paging *core.Paging) ([]entity.Label, error) {
+	var labels []entity.Label
+
+	db := r.db.Table(entity.Label{}.TableName())
+
+	if filter.UserID != nil {
+		db = db.Where("user_id = ?", *filter.UserID)
+	}
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("name asc").
+		Find(&labels).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return labels, nil
+}
+
+// AttachToTask attaches labels to a task. Labels already attached are
+// ignored.
+func (r *labelRepo) AttachToTask(_ context.Context, taskID uint, labelIDs []uint) error {
+	if len(labelIDs) == 0 {
+		return nil
+	}
+
+	taskLabels := make([]entity.TaskLabel, len(labelIDs))
+
+	for i := range labelIDs {
+		taskLabels[i] = entity.TaskLabel{TaskID: taskID, LabelID: labelIDs[i]}
+	}
+
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&taskLabels).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DetachFromTask detaches a label from a task.
+func (r *labelRepo) DetachFromTask(_ context.Context, taskID uint, labelID uint) error {
+	if err := r.db.
+		Where("task_id = ? AND label_id = ?", taskID, labelID).
+		Delete(&entity.TaskLabel{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindByTaskIDs fetches labels of list of tasks, grouped by task id.
+func (r *labelRepo) FindByTaskIDs(_ context.Context, taskIDs []uint) (map[uint][]entity.Label, error) {
+	result := make(map[uint][]entity.Label)
+	if len(taskIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		entity.Label
+		TaskID uint `gorm:"column:task_id"`
+	}
+
+	if err := r.db.
+		Table(entity.Label{}.TableName()+" AS l").
+		Select("l.*, tl.task_id").
+		Joins("JOIN "+entity.TaskLabel{}.TableName()+" AS tl ON tl.label_id = l.id").
+		Where("tl.task_id IN ?", taskIDs).
+		Order("l.name asc").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = append(result[row.TaskID], row.Label)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// mentionRepo implements methods of mention's repository.
+type mentionRepo struct {
+	db *gorm.DB
+}
+
+// NewMentionRepo creates and returns a new instance of MentionRepo.
+func NewMentionRepo(db *gorm.DB) store.MentionRepo {
+	return &mentionRepo{db: db}
+}
+
+// ReplaceForTask replaces users mentioned in description of a task.
+func (r *mentionRepo) ReplaceForTask(_ context.Context, taskID uint, userIDs []uint) error {
+	return r.replace(taskID, nil, userIDs)
+}
+
+// ReplaceForComment replaces users mentioned in a comment of a task.
+func (r *mentionRepo) ReplaceForComment(_ context.Context, taskID uint, commentID uint, userIDs []uint) error {
+	return r.replace(taskID, &commentID, userIDs)
+}
+
+// replace deletes mentions of description of a task, or of one of its
+// comments, and inserts the new ones in one transaction.
+func (r *mentionRepo) replace(taskID uint, commentID *uint, userIDs []uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		db := tx.Where("task_id = ?", taskID)
+		if commentID != nil {
+			db = db.Where("comment_id = ?", *commentID)
+		} else {
+			db = db.Where("comment_id IS NULL")
+		}
+
+		if err := db.Delete(&entity.Mention{}).Error; err != nil {
+			return err
+		}
+
+		if len(userIDs) == 0 {
+			return nil
+		}
+
+		mentions := make([]entity.Mention, len(userIDs))
+
+		for i := range userIDs {
+			mentions[i] = entity.Mention{TaskID: taskID, CommentID: commentID, UserID: userIDs[i]}
+		}
+
+		return tx.Create(&mentions).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package storeimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// notificationRepo implements methods of notification's repository.
+type notificationRepo struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepo creates and returns a new instance of NotificationRepo.
+func NewNotificationRepo(db *gorm.DB) store.NotificationRepo {
+	return &notificationRepo{db: db}
+}
+
+// InsertMany inserts list of notifications to database. A notification of a
+// reminder already recorded for the same user is left out.
+func (r *notificationRepo) InsertMany(_ context.Context, notifications []entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	// Other notifications have no reminder, so they never conflict
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&notifications).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindRangeByCriteria fetches list of notifications by criteria, newest
+// first.
+func (r *notificationRepo) FindRangeByCriteria(ctx context.Context, filter *entity.NotificationFilter, paging *core.Paging) ([]entity.Notification, error) {
+	var notifications []entity.Notification
+
+	db := r.db.
+		Table(entity.Notification{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("user_id = ?", filter.UserID)
+
+	if filter.UnreadOnly {
+		db = db.Where("read_at IS NULL")
+	}
+
+	if filter.Type != nil {
+		db = db.Where("type = ?", *filter.Type)
+	}
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id desc").
+		Find(&notifications).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return notifications, nil
+}
+
+// CountUnread counts notifications of an user which are not read yet.
+func (r *notificationRepo) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+
+	if err := r.db.
+		Table(entity.Notification{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// MarkRead marks a notification of an user as read.
+func (r *notificationRepo) MarkRead(ctx context.Context, userID uint, id uint, readAt time.Time) (bool, error) {
+	var ids []uint
+
+	if err := r.db.
+		Table(entity.Notification{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ? AND user_id = ?", id, userID).
+		Pluck("id", &ids).Error; err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	if len(ids) == 0 {
+		return false, nil
+	}
+
+	// Reading again keeps the time it was first read
+	if err := r.db.
+		Table(entity.Notification{}.TableName()).
+		Where("id = ? AND read_at IS NULL", id).
+		UpdateColumn("read_at", readAt).Error; err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return true, nil
+}
+
+// MarkAllRead marks all notifications of an user as read.
+func (r *notificationRepo) MarkAllRead(ctx context.Context, userID uint, readAt time.Time) error {
+	if err := r.db.
+		Table(entity.Notification{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		UpdateColumn("read_at", readAt).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
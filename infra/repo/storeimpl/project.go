@@ -0,0 +1,171 @@
+package storeimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// projectRepo implements methods of project's repository.
+type projectRepo struct {
+	db *gorm.DB
+}
+
+// NewProjectRepo creates and returns a new instance of ProjectRepo.
+func NewProjectRepo(db *gorm.DB) store.ProjectRepo {
+	return &projectRepo{db: db}
+}
+
+// InsertOne inserts a project to database.
+func (r *projectRepo) InsertOne(ctx context.Context, project *entity.Project) error {
+	// Project always belongs to tenant of requester
+	project.TenantID = tenantFromContext(ctx)
+
+	if err := r.db.Create(project).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// UpdateOne updates a project to database.
+func (r *projectRepo) UpdateOne(ctx context.Context, project *entity.Project) error {
+	// Select all columns so workflow can be cleared too
+	if err := r.db.Table(project.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ?", project.ID).
+		Select("*").
+		Omit("id", "tenant_id", "created_at").
+		Updates(project).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne soft deletes a project and all its tasks.
+func (r *projectRepo) DeleteOne(ctx context.Context, id uint) error {
+	return r.cascadeStatus(ctx, id, entity.ProjectStatusDeleted, entity.StatusDeleted, []entity.Status{entity.StatusDeleted})
+}
+
+// ArchiveOne archives a project and all its tasks which are not deleted.
+func (r *projectRepo) ArchiveOne(ctx context.Context, id uint) error {
+	return r.cascadeStatus(ctx, id, entity.ProjectStatusArchived, entity.StatusArchived, []entity.Status{entity.StatusDeleted, entity.StatusArchived})
+}
+
+// FindOne fetches a project from database by id.
+func (r *projectRepo) FindOne(ctx context.Context, id uint) (*entity.Project, error) {
+	var data entity.Project
+
+	if err := r.db.
+		Table(data.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindRangeByCriteria fetches list of projects by criteria.
+func (r *projectRepo) FindRangeByCriteria(ctx context.Context, filter *entity.ProjectFilter, paging *core.Paging) ([]entity.Project, error) {
+	var projects []entity.Project
+
+	db := r.db.
+		Table(entity.Project{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("status <> ?", entity.ProjectStatusDeleted)
+
+	if filter.UserID != nil {
+		db = db.Where("user_id = ?", *filter.UserID)
+	}
+
+	if filter.MemberID != nil {
+		bound := r.db.
+			Table(entity.RoleBinding{}.TableName()).
+			Select("project_id").
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("user_id = ? AND project_id IS NOT NULL", *filter.MemberID)
+
+		db = db.Where("(user_id = ? OR id IN (?))", *filter.MemberID, bound)
+	}
+
+	if filter.Status != nil {
+		db = db.Where("status = ?", *filter.Status)
+	}
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id desc").
+		Find(&projects).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return projects, nil
+}
+
+// cascadeStatus sets status of a project, and sets status of its tasks to
+// taskStatus unless they are in one of skipped statuses, in one transaction.
+// Project of another tenant is left untouched along with its tasks.
+func (r *projectRepo) cascadeStatus(ctx context.Context, id uint, status entity.ProjectStatus, taskStatus entity.Status, skipped []entity.Status) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Table(entity.Project{}.TableName()).
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"status": status,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		// Soft status for tasks, keeping their current status so they can be
+		// restored later
+		tasks := tx.Table(entity.Task{}.TableName()).
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("project_id = ? AND status NOT IN ?", id, skipped)
+
+		if err := tasks.Session(&gorm.Session{}).
+			UpdateColumn("previous_status", gorm.Expr("status")).Error; err != nil {
+			return err
+		}
+
+		values := map[string]interface{}{
+			"status":          taskStatus,
+			"status_category": taskStatus.Category(),
+		}
+		if taskStatus == entity.StatusDeleted {
+			values["deleted_at"] = time.Now()
+		}
+
+		return tasks.Session(&gorm.Session{}).Updates(values).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package storeimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// reminderRepo implements methods of reminder's repository.
+type reminderRepo struct {
+	db *gorm.DB
+}
+
+// NewReminderRepo creates and returns a new instance of ReminderRepo.
+func NewReminderRepo(db *gorm.DB) store.ReminderRepo {
+	return &reminderRepo{db: db}
+}
+
+// InsertOne inserts a reminder to database.
+func (r *reminderRepo) InsertOne(_ context.Context, reminder *entity.Reminder) error {
+	if err := r.db.Create(reminder).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a reminder from database.
+func (r *reminderRepo) DeleteOne(_ context.Context, id uint) error {
+	if err := r.db.Where("id = ?", id).Delete(&entity.Reminder{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches a reminder from database by id.
+func (r *reminderRepo) FindOne(_ context.Context, id uint) (*entity.Reminder, error) {
+	var data entity.Reminder
+
+	if err := r.db.Where("id = ?", id).First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindByTaskID fetches reminders of an user on a task, soonest first.
+func (r *reminderRepo) FindByTaskID(_ context.Context, taskID uint, userID uint) ([]entity.Reminder, error) {
+	var reminders []entity.Reminder
+
+	if err := r.db.
+		Where("task_id = ? AND user_id = ?", taskID, userID).
+		Order("remind_at IS NULL, remind_at asc, id asc").
+		Find(&reminders).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return reminders, nil
+}
+
+// RescheduleForTask moves reminders of a task which are relative to its due
+// date and not fired yet, following the new due date.
+func (r *reminderRepo) RescheduleForTask(_ context.Context, taskID uint, dueAt *time.Time) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var reminders []entity.Reminder
+		if err := tx.
+			Where("task_id = ? AND before_due_minutes IS NOT NULL AND fired_at IS NULL", taskID).
+			Find(&reminders).Error; err != nil {
+			return err
+		}
+
+		for i := range reminders {
+			reminders[i].Schedule(dueAt)
+
+			if err := tx.Model(&entity.Reminder{}).
+				Where("id = ?", reminders[i].ID).
+				UpdateColumn("remind_at", reminders[i].RemindAt).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// ClaimDue fetches reminders of all tenants which are due at the given time
+// and not fired yet, locking them for lease.
+func (r *reminderRepo) ClaimDue(_ context.Context, now time.Time, lease time.Duration, limit int) ([]entity.Reminder, error) {
+	var reminders []entity.Reminder
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Rows being claimed by another instance are skipped rather than
+		// waited for, so each reminder goes to one instance only
+		if err := tx.Table("task_reminders r").
+			Select("r.*").
+			Joins("JOIN tasks t ON t.id = r.task_id").
+			Where("r.fired_at IS NULL AND r.remind_at <= ?", now).
+			Where("r.locked_until IS NULL OR r.locked_until < ?", now).
+			Where("t.status NOT IN ? AND t.status_category <> ?",
+				[]entity.Status{entity.StatusDeleted, entity.StatusArchived}, entity.StatusCategoryClosed).
+			Order("r.remind_at asc").
+			Limit(limit).
+			Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "r"}, Options: "SKIP LOCKED"}).
+			Find(&reminders).Error; err != nil {
+			return err
+		}
+
+		if len(reminders) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(reminders))
+		taskIDs := make([]uint, len(reminders))
+
+		for i := range reminders {
+			ids[i] = reminders[i].ID
+			taskIDs[i] = reminders[i].TaskID
+		}
+
+		if err := tx.Model(&entity.Reminder{}).
+			Where("id IN ?", ids).
+			UpdateColumn("locked_until", now.Add(lease)).Error; err != nil {
+			return err
+		}
+
+		var tasks []entity.Task
+		if err := tx.Where("id IN ?", taskIDs).Find(&tasks).Error; err != nil {
+			return err
+		}
+
+		// For speed up mapping data
+		taskMap := make(map[uint]*entity.Task, len(tasks))
+
+		for i := range tasks {
+			taskMap[tasks[i].ID] = &tasks[i]
+		}
+
+		for i := range reminders {
+			reminders[i].Task = taskMap[reminders[i].TaskID]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return reminders, nil
+}
+
+// MarkFired marks a claimed reminder as fired.
+func (r *reminderRepo) MarkFired(_ context.Context, id uint, firedAt time.Time) error {
+	if err := r.db.Model(&entity.Reminder{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"fired_at":     firedAt,
+			"locked_until": nil,
+		}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
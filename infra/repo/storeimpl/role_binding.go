@@ -0,0 +1,124 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// roleBindingRepo implements methods of role binding's repository.
+type roleBindingRepo struct {
+	db *gorm.DB
+}
+
+// NewRoleBindingRepo creates and returns a new instance of RoleBindingRepo.
+func NewRoleBindingRepo(db *gorm.DB) store.RoleBindingRepo {
+	return &roleBindingRepo{db: db}
+}
+
+// UpsertOne grants a role to an user on a project, replacing the role granted
+// before if any.
+func (r *roleBindingRepo) UpsertOne(ctx context.Context, binding *entity.RoleBinding) error {
+	binding.TenantID = tenantFromContext(ctx)
+	binding.ProjectKey = 0
+	if binding.ProjectID != nil {
+		binding.ProjectKey = *binding.ProjectID
+	}
+
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "project_key"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(binding).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne revokes role of an user on a project.
+func (r *roleBindingRepo) DeleteOne(ctx context.Context, projectID uint, userID uint) error {
+	if err := r.db.
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Delete(&entity.RoleBinding{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOneOnTenant revokes role of an user on whole tenant.
+func (r *roleBindingRepo) DeleteOneOnTenant(ctx context.Context, userID uint) error {
+	if err := r.db.
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("project_id IS NULL AND user_id = ?", userID).
+		Delete(&entity.RoleBinding{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindRoles fetches roles of an user on whole tenant and, if projectID is
+// given, on that project.
+func (r *roleBindingRepo) FindRoles(ctx context.Context, userID uint, projectID *uint) ([]entity.Role, error) {
+	var roles []entity.Role
+
+	db := r.db.
+		Table(entity.RoleBinding{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("user_id = ?", userID)
+
+	if projectID != nil {
+		db = db.Where("(project_id IS NULL OR project_id = ?)", *projectID)
+	} else {
+		db = db.Where("project_id IS NULL")
+	}
+
+	if err := db.Pluck("role", &roles).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return roles, nil
+}
+
+// FindRangeByProject fetches list of role bindings on a project.
+func (r *roleBindingRepo) FindRangeByProject(ctx context.Context, projectID uint, paging *core.Paging) ([]entity.RoleBinding, error) {
+	return r.findRange(ctx, r.db.Where("project_id = ?", projectID), paging)
+}
+
+// FindRangeOnTenant fetches list of role bindings on whole tenant.
+func (r *roleBindingRepo) FindRangeOnTenant(ctx context.Context, paging *core.Paging) ([]entity.RoleBinding, error) {
+	return r.findRange(ctx, r.db.Where("project_id IS NULL"), paging)
+}
+
+// findRange fetches a page of role bindings matching conditions of db.
+func (r *roleBindingRepo) findRange(ctx context.Context, db *gorm.DB, paging *core.Paging) ([]entity.RoleBinding, error) {
+	var bindings []entity.RoleBinding
+
+	db = db.
+		Table(entity.RoleBinding{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id"))
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id asc").
+		Find(&bindings).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return bindings, nil
+}
@@ -0,0 +1,53 @@
+package storeimpl
+
+import (
+	"testing"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+func TestRoleBindingRepo_UpsertOneReplacesRole(t *testing.T) {
+	db := newTestDB(t, &entity.RoleBinding{})
+	repo := &roleBindingRepo{db: db}
+	ctx := tenantContext("a")
+
+	projectID := uint(1)
+
+	grants := []entity.RoleBinding{
+		{ProjectID: &projectID, UserID: 1, Role: entity.RoleViewer},
+		{ProjectID: &projectID, UserID: 1, Role: entity.RoleEditor},
+		// Tenant bindings have no project, they still must not pile up
+		{UserID: 1, Role: entity.RoleViewer},
+		{UserID: 1, Role: entity.RoleAdmin},
+	}
+
+	for i := range grants {
+		if err := repo.UpsertOne(ctx, &grants[i]); err != nil {
+			t.Fatalf("upsert binding %d: %v", i, err)
+		}
+	}
+
+	var count int64
+	if err := db.Model(&entity.RoleBinding{}).Count(&count).Error; err != nil {
+		t.Fatalf("count bindings: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("bindings = %d, want 2", count)
+	}
+
+	roles, err := repo.FindRoles(ctx, 1, nil)
+	if err != nil {
+		t.Fatalf("find tenant roles: %v", err)
+	}
+	if len(roles) != 1 || roles[0] != entity.RoleAdmin {
+		t.Errorf("tenant roles = %v, want [%s]", roles, entity.RoleAdmin)
+	}
+
+	roles, err = repo.FindRoles(ctx, 1, &projectID)
+	if err != nil {
+		t.Fatalf("find project roles: %v", err)
+	}
+	if entity.MaxRole(roles...) != entity.RoleAdmin || len(roles) != 2 {
+		t.Errorf("project roles = %v, want editor and admin", roles)
+	}
+}
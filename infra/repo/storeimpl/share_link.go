@@ -0,0 +1,108 @@
+package storeimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// shareLinkRepo implements methods of share link's repository.
+type shareLinkRepo struct {
+	db *gorm.DB
+}
+
+// NewShareLinkRepo creates and returns a new instance of ShareLinkRepo.
+func NewShareLinkRepo(db *gorm.DB) store.ShareLinkRepo {
+	return &shareLinkRepo{db: db}
+}
+
+// InsertOne inserts a share link to database.
+func (r *shareLinkRepo) InsertOne(ctx context.Context, link *entity.ShareLink) error {
+	// Link gives access to task of tenant of its creator only
+	link.TenantID = tenantFromContext(ctx)
+
+	if err := r.db.Create(link).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// RevokeOne revokes a share link so it can not be used anymore.
+func (r *shareLinkRepo) RevokeOne(_ context.Context, id uint) error {
+	if err := r.db.Table(entity.ShareLink{}.TableName()).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches a share link from database by id.
+func (r *shareLinkRepo) FindOne(_ context.Context, id uint) (*entity.ShareLink, error) {
+	return r.findOneBy("id = ?", id)
+}
+
+// FindByTokenHash fetches a share link from database by hash of its token.
+func (r *shareLinkRepo) FindByTokenHash(_ context.Context, tokenHash string) (*entity.ShareLink, error) {
+	return r.findOneBy("token_hash = ?", tokenHash)
+}
+
+// FindByTaskID fetches all share links of a task, revoked ones included.
+func (r *shareLinkRepo) FindByTaskID(_ context.Context, taskID uint) ([]entity.ShareLink, error) {
+	var links []entity.ShareLink
+
+	if err := r.db.
+		Table(entity.ShareLink{}.TableName()).
+		Where("task_id = ?", taskID).
+		Order("id desc").
+		Find(&links).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return links, nil
+}
+
+// InsertAccess records an access through a share link, and keeps time of the
+// last access on the link.
+func (r *shareLinkRepo) InsertAccess(_ context.Context, access *entity.ShareLinkAccess) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(access).Error; err != nil {
+			return err
+		}
+
+		return tx.Table(entity.ShareLink{}.TableName()).
+			Where("id = ?", access.LinkID).
+			Update("accessed_at", access.CreatedAt).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// findOneBy fetches a share link from database matching condition.
+func (r *shareLinkRepo) findOneBy(query string, args ...interface{}) (*entity.ShareLink, error) {
+	var data entity.ShareLink
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where(query, args...).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
@@ -2,6 +2,7 @@ package storeimpl
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	kiterrors "github.com/quocdaitrn/golang-kit/errors"
@@ -12,6 +13,16 @@ import (
 	"github.com/quocdaitrn/cp-task/domain/repo/store"
 )
 
+// taskSortColumns whitelists columns which tasks can be sorted by, so no raw
+// input from clients reaches the query.
+var taskSortColumns = map[entity.SortField]string{
+	entity.SortFieldPriority:  "priority",
+	entity.SortFieldDueAt:     "due_at",
+	entity.SortFieldCreatedAt: "created_at",
+	entity.SortFieldUpdatedAt: "updated_at",
+	entity.SortFieldTitle:     "title",
+}
+
 // taskRepo implements methods of task's repository.
 type taskRepo struct {
 	db *gorm.DB
@@ -23,7 +34,10 @@ func NewTaskRepo(db *gorm.DB) store.TaskRepo {
 }
 
 // InsertOne inserts a task to database.
-func (r *taskRepo) InsertOne(_ context.Context, task *entity.Task) error {
+func (r *taskRepo) InsertOne(ctx context.Context, task *entity.Task) error {
+	// Task always belongs to tenant of requester
+	task.TenantID = tenantFromContext(ctx)
+
 	if err := r.db.Create(task).Error; err != nil {
 		return errors.WithStack(err)
 	}
@@ -32,8 +46,14 @@ func (r *taskRepo) InsertOne(_ context.Context, task *entity.Task) error {
 }
 
 // UpdateOne updates a task to database.
-func (r *taskRepo) UpdateOne(_ context.Context, task *entity.Task) error {
-	if err := r.db.Table(task.TableName()).Where("id = ?", task.ID).Updates(task).Error; err != nil {
+func (r *taskRepo) UpdateOne(ctx context.Context, task *entity.Task) error {
+	// Select all columns so zero values (e.g. priority none) are persisted too
+	if err := r.db.Table(task.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ?", task.ID).
+		Select("*").
+		Omit("id", "tenant_id", "created_at").
+		Updates(task).Error; err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -41,25 +61,247 @@ func (r *taskRepo) UpdateOne(_ context.Context, task *entity.Task) error {
 }
 
 // DeleteOne deletes a task from database.
-func (r *taskRepo) DeleteOne(_ context.Context, id uint) error {
-	// Soft delete
+func (r *taskRepo) DeleteOne(ctx context.Context, id uint) error {
+	// Soft delete, keeping current status so the task can be restored
+	return r.setStatusAside(ctx, id, entity.StatusDeleted, map[string]interface{}{
+		"deleted_at": time.Now(),
+	})
+}
+
+// ArchiveOne archives a task.
+func (r *taskRepo) ArchiveOne(ctx context.Context, id uint) error {
+	return r.setStatusAside(ctx, id, entity.StatusArchived, nil)
+}
+
+// setStatusAside moves current status of a task to its previous status, then
+// sets the given status along with extra values, in one transaction.
+func (r *taskRepo) setStatusAside(ctx context.Context, id uint, status entity.Status, extra map[string]interface{}) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		db := tx.Table(entity.Task{}.TableName()).
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("id = ?", id)
+
+		if err := db.Session(&gorm.Session{}).
+			UpdateColumn("previous_status", gorm.Expr("status")).Error; err != nil {
+			return err
+		}
+
+		values := map[string]interface{}{
+			"status":          status,
+			"status_category": status.Category(),
+		}
+		for k, v := range extra {
+			values[k] = v
+		}
+
+		return db.Session(&gorm.Session{}).Updates(values).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// Purge permanently deletes a task and all its related data from database.
+func (r *taskRepo) Purge(ctx context.Context, id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Make sure task belongs to tenant of requester before touching
+		// related data, which has no tenant of its own
+		var ids []uint
+		if err := tx.Table(entity.Task{}.TableName()).
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("id = ?", id).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+
+		return purgeTasks(tx, ids)
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindDeletedBefore fetches ids of tasks of all tenants which were moved to
+// trash before the given time.
+func (r *taskRepo) FindDeletedBefore(_ context.Context, before time.Time, limit int) ([]uint, error) {
+	var ids []uint
+
 	if err := r.db.Table(entity.Task{}.TableName()).
-		Where("id = ?", id).
-		Updates(map[string]interface{}{
-			"status": entity.StatusDeleted,
-		}).Error; err != nil {
+		Where("status = ? AND deleted_at < ?", entity.StatusDeleted, before).
+		Order("id asc").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
+
+// PurgeMany permanently deletes tasks of all tenants with given ids and all
+// their related data from database.
+func (r *taskRepo) PurgeMany(_ context.Context, ids []uint) error {
+	if err := r.db.Transaction(func(tx *gorm.DB) error {
+		return purgeTasks(tx, ids)
+	}); err != nil {
 		return errors.WithStack(err)
 	}
 
 	return nil
 }
 
+// FindDueRecurring fetches recurring tasks of all tenants whose next
+// occurrence is due before the given time.
+func (r *taskRepo) FindDueRecurring(_ context.Context, before time.Time, limit int) ([]entity.Task, error) {
+	var tasks []entity.Task
+
+	if err := r.db.Table(entity.Task{}.TableName()).
+		Where("recurrence IS NOT NULL AND next_occurrence_at <= ?", before).
+		Where("status NOT IN ?", []entity.Status{entity.StatusDeleted, entity.StatusArchived}).
+		Order("next_occurrence_at asc").
+		Limit(limit).
+		Find(&tasks).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return tasks, nil
+}
+
+// InsertNextOccurrence inserts next occurrence of a recurring task, along with
+// labels, assignees, watchers, mentions and reminders relative to due date of
+// the task.
+func (r *taskRepo) InsertNextOccurrence(_ context.Context, prev *entity.Task, next *entity.Task) (bool, error) {
+	inserted := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if prev.NextOccurrenceAt == nil {
+			return nil
+		}
+
+		// Claim the occurrence first, so concurrent or repeated calls (e.g.
+		// completing a task while the scheduler runs) insert it only once
+		result := tx.Table(entity.Task{}.TableName()).
+			Where("id = ? AND next_occurrence_at = ?", prev.ID, *prev.NextOccurrenceAt).
+			UpdateColumn("next_occurrence_at", nil)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		// Occurrence belongs to the tenant of its series, which may not be
+		// the one of requester for background jobs
+		next.TenantID = prev.TenantID
+
+		if err := tx.Create(next).Error; err != nil {
+			return err
+		}
+
+		var labels []entity.TaskLabel
+		if err := tx.Where("task_id = ?", prev.ID).Find(&labels).Error; err != nil {
+			return err
+		}
+
+		var assignees []entity.TaskAssignee
+		if err := tx.Where("task_id = ?", prev.ID).Find(&assignees).Error; err != nil {
+			return err
+		}
+
+		var watchers []entity.TaskWatcher
+		if err := tx.Where("task_id = ?", prev.ID).Find(&watchers).Error; err != nil {
+			return err
+		}
+
+		// Reminders relative to due date follow the series, absolute ones
+		// belong to prev only
+		var reminders []entity.Reminder
+		if err := tx.Where("task_id = ? AND before_due_minutes IS NOT NULL", prev.ID).Find(&reminders).Error; err != nil {
+			return err
+		}
+
+		// Only mentions in description are copied, comments stay with prev
+		var mentions []entity.Mention
+		if err := tx.Where("task_id = ? AND comment_id IS NULL", prev.ID).Find(&mentions).Error; err != nil {
+			return err
+		}
+
+		for i := range labels {
+			labels[i] = entity.TaskLabel{TaskID: next.ID, LabelID: labels[i].LabelID}
+		}
+
+		for i := range assignees {
+			assignees[i] = entity.TaskAssignee{TaskID: next.ID, UserID: assignees[i].UserID}
+		}
+
+		for i := range watchers {
+			watchers[i] = entity.TaskWatcher{TaskID: next.ID, UserID: watchers[i].UserID}
+		}
+
+		for i := range reminders {
+			reminders[i] = entity.Reminder{
+				TaskID:           next.ID,
+				UserID:           reminders[i].UserID,
+				BeforeDueMinutes: reminders[i].BeforeDueMinutes,
+			}
+			reminders[i].Schedule(next.DueAt)
+		}
+
+		for i := range mentions {
+			mentions[i].TaskID = next.ID
+		}
+
+		if len(labels) > 0 {
+			if err := tx.Create(&labels).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(assignees) > 0 {
+			if err := tx.Create(&assignees).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(watchers) > 0 {
+			if err := tx.Create(&watchers).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(reminders) > 0 {
+			if err := tx.Create(&reminders).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(mentions) > 0 {
+			if err := tx.Create(&mentions).Error; err != nil {
+				return err
+			}
+		}
+
+		inserted = true
+		return nil
+	})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return inserted, nil
+}
+
 // FindOne fetches a task from database by id.
-func (r *taskRepo) FindOne(_ context.Context, id uint) (*entity.Task, error) {
+func (r *taskRepo) FindOne(ctx context.Context, id uint) (*entity.Task, error) {
 	var data entity.Task
 
 	if err := r.db.
 		Table(data.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
 		Where("id = ?", id).
 		First(&data).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -73,21 +315,151 @@ func (r *taskRepo) FindOne(_ context.Context, id uint) (*entity.Task, error) {
 }
 
 // FindRangeByCriteria fetches list of tasks by criteria.
-func (r *taskRepo) FindRangeByCriteria(ctx context.Context, filter *entity.Filter, paging *core.Paging) ([]entity.Task, error) {
+func (r *taskRepo) FindRangeByCriteria(ctx context.Context, filter *entity.Filter, sort *entity.Sort, paging *core.Paging) ([]entity.Task, error) {
 	var tasks []entity.Task
 
 	db := r.db.
 		Table(entity.Task{}.TableName()).
-		Where("status <> ?", entity.StatusDeleted)
+		Scopes(tenantScope(ctx, "tenant_id"))
+
+	if filter.Trashed {
+		db = db.Where("status = ?", entity.StatusDeleted)
+	} else {
+		db = db.Where("status <> ?", entity.StatusDeleted)
+	}
 
 	if filter.UserID != nil {
 		db = db.Where("user_id = ?", *filter.UserID)
 	}
 
+	if filter.AssigneeID != nil {
+		db = db.Where("id IN (?)", r.db.
+			Table(entity.TaskAssignee{}.TableName()).
+			Select("task_id").
+			Where("user_id = ?", *filter.AssigneeID))
+	}
+
+	if filter.MentionedID != nil {
+		// Tasks mentioning user in their description or comments
+		db = db.Where("id IN (?)", r.db.
+			Table(entity.Mention{}.TableName()).
+			Select("task_id").
+			Where("user_id = ?", *filter.MentionedID))
+	}
+
+	if filter.WatcherID != nil {
+		db = db.Where("id IN (?)", r.db.
+			Table(entity.TaskWatcher{}.TableName()).
+			Select("task_id").
+			Where("user_id = ?", *filter.WatcherID))
+	}
+
+	if filter.ParentID != nil {
+		db = db.Where("parent_id = ?", *filter.ParentID)
+	}
+
+	if filter.ProjectID != nil {
+		db = db.Where("project_id = ?", *filter.ProjectID)
+	}
+
+	if filter.WorkflowID != nil {
+		db = db.Where("workflow_id = ?", *filter.WorkflowID)
+	}
+
 	if filter.Status != nil {
 		db = db.Where("status = ?", *filter.Status)
 	}
 
+	if filter.StatusCategory != nil {
+		db = db.Where("status_category = ?", *filter.StatusCategory)
+	}
+
+	if filter.Priority != nil {
+		db = db.Where("priority = ?", *filter.Priority)
+	}
+
+	if filter.DueBefore != nil {
+		db = db.Where("due_at < ?", *filter.DueBefore)
+	}
+
+	if filter.DueAfter != nil {
+		db = db.Where("due_at > ?", *filter.DueAfter)
+	}
+
+	if filter.Overdue != nil {
+		now := time.Now()
+		if *filter.Overdue {
+			db = db.Where("due_at IS NOT NULL AND due_at < ? AND status_category <> ?", now, entity.StatusCategoryClosed)
+		} else {
+			db = db.Where("(due_at IS NULL OR due_at >= ? OR status_category = ?)", now, entity.StatusCategoryClosed)
+		}
+	}
+
+	if filter.Archived != nil {
+		if *filter.Archived {
+			db = db.Where("status = ?", entity.StatusArchived)
+		} else {
+			db = db.Where("status <> ?", entity.StatusArchived)
+		}
+	}
+
+	if filter.Blocked != nil {
+		// Tasks having at least one blocker not closed yet
+		sub := r.db.
+			Table(entity.TaskDependency{}.TableName()+" AS d").
+			Select("d.task_id").
+			Joins("JOIN "+entity.Task{}.TableName()+" AS b ON b.id = d.blocker_id").
+			Scopes(tenantScope(ctx, "b.tenant_id")).
+			Where("b.status_category <> ?", entity.StatusCategoryClosed)
+
+		if *filter.Blocked {
+			db = db.Where("id IN (?)", sub)
+		} else {
+			db = db.Where("id NOT IN (?)", sub)
+		}
+	}
+
+	if len(filter.LabelIDs) > 0 {
+		sub := r.db.
+			Table(entity.TaskLabel{}.TableName()).
+			Select("task_id").
+			Where("label_id IN ?", filter.LabelIDs)
+
+		if filter.LabelMatch == entity.LabelMatchAll {
+			sub = sub.Group("task_id").Having("COUNT(DISTINCT label_id) = ?", len(filter.LabelIDs))
+		}
+
+		db = db.Where("id IN (?)", sub)
+	}
+
+	if filter.VisibleTo != nil {
+		userID := *filter.VisibleTo
+
+		assigned := r.db.
+			Table(entity.TaskAssignee{}.TableName()).
+			Select("task_id").
+			Where("user_id = ?", userID)
+
+		owned := r.db.
+			Table(entity.Project{}.TableName()).
+			Select("id").
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("user_id = ?", userID)
+
+		bound := r.db.
+			Table(entity.RoleBinding{}.TableName()).
+			Select("project_id").
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("user_id = ? AND project_id IS NOT NULL", userID)
+
+		shared := r.db.
+			Table(entity.TaskShare{}.TableName()).
+			Select("task_id").
+			Where("user_id = ?", userID)
+
+		db = db.Where("(user_id = ? OR id IN (?) OR id IN (?) OR project_id IN (?) OR project_id IN (?))", userID, assigned, shared, owned, bound)
+	}
+
 	// Count total records match conditions
 	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
 		return nil, errors.WithStack(err)
@@ -97,10 +469,133 @@ func (r *taskRepo) FindRangeByCriteria(ctx context.Context, filter *entity.Filte
 	if err := db.Select("*").
 		Offset((paging.Page - 1) * paging.Limit).
 		Limit(paging.Limit).
-		Order("id desc").
+		Order(taskOrder(sort)).
 		Find(&tasks).Error; err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	return tasks, nil
 }
+
+// CountSubtasks counts total and done subtasks of list of tasks, grouped by
+// parent task id.
+func (r *taskRepo) CountSubtasks(ctx context.Context, parentIDs []uint) (map[uint]entity.SubtaskStats, error) {
+	result := make(map[uint]entity.SubtaskStats)
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		ParentID uint  `gorm:"column:parent_id"`
+		Total    int64 `gorm:"column:total"`
+		Done     int64 `gorm:"column:done"`
+	}
+
+	if err := r.db.
+		Table(entity.Task{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Select("parent_id, COUNT(*) AS total, SUM(CASE WHEN status_category = ? THEN 1 ELSE 0 END) AS done", entity.StatusCategoryClosed).
+		Where("parent_id IN ? AND status <> ?", parentIDs, entity.StatusDeleted).
+		Group("parent_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, row := range rows {
+		result[row.ParentID] = entity.SubtaskStats{Total: row.Total, Done: row.Done}
+	}
+
+	return result, nil
+}
+
+// CountByWorkflow counts tasks using workflow, or a specific status of it if
+// status is given. Deleted and archived tasks count too, by the status they
+// will be restored to.
+func (r *taskRepo) CountByWorkflow(ctx context.Context, workflowID uint, status *entity.Status) (int64, error) {
+	var count int64
+
+	db := r.db.
+		Table(entity.Task{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("workflow_id = ?", workflowID)
+
+	if status != nil {
+		db = db.Where("(status = ? OR previous_status = ?)", *status, *status)
+	}
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return count, nil
+}
+
+// purgeTasks deletes tasks with given ids and all data related to them in
+// transaction tx. Subtasks which are not purged along are moved to top level.
+func purgeTasks(tx *gorm.DB, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	links := tx.Table(entity.ShareLink{}.TableName()).
+		Select("id").
+		Where("task_id IN ?", ids)
+
+	if err := tx.Where("link_id IN (?)", links).
+		Delete(&entity.ShareLinkAccess{}).Error; err != nil {
+		return err
+	}
+
+	related := []interface{}{
+		&entity.ShareLink{},
+		&entity.TaskAssignee{},
+		&entity.TaskLabel{},
+		&entity.TaskShare{},
+		&entity.TaskWatcher{},
+		&entity.Mention{},
+		&entity.Comment{},
+		&entity.Activity{},
+		&entity.ChecklistItem{},
+		&entity.Attachment{},
+		&entity.Reminder{},
+		&entity.Notification{},
+	}
+
+	for _, model := range related {
+		if err := tx.Where("task_id IN ?", ids).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("task_id IN ? OR blocker_id IN ?", ids, ids).
+		Delete(&entity.TaskDependency{}).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Table(entity.Task{}.TableName()).
+		Where("parent_id IN ?", ids).
+		UpdateColumn("parent_id", nil).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("id IN ?", ids).Delete(&entity.Task{}).Error
+}
+
+// taskOrder builds order clause from sort, falling back to newest first.
+func taskOrder(sort *entity.Sort) string {
+	if sort == nil {
+		return "id desc"
+	}
+
+	column, ok := taskSortColumns[sort.Field]
+	if !ok {
+		return "id desc"
+	}
+
+	direction := "asc"
+	if sort.Direction == entity.SortDesc {
+		direction = "desc"
+	}
+
+	return column + " " + direction + ", id desc"
+}
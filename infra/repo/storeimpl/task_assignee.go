@@ -0,0 +1,77 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// taskAssigneeRepo implements methods of task assignee's repository.
+type taskAssigneeRepo struct {
+	db *gorm.DB
+}
+
+// NewTaskAssigneeRepo creates and returns a new instance of TaskAssigneeRepo.
+func NewTaskAssigneeRepo(db *gorm.DB) store.TaskAssigneeRepo {
+	return &taskAssigneeRepo{db: db}
+}
+
+// InsertMany assigns users to a task. Users already assigned are ignored.
+func (r *taskAssigneeRepo) InsertMany(_ context.Context, taskID uint, userIDs []uint) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	assignees := make([]entity.TaskAssignee, len(userIDs))
+
+	for i := range userIDs {
+		assignees[i] = entity.TaskAssignee{TaskID: taskID, UserID: userIDs[i]}
+	}
+
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&assignees).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne unassigns an user from a task.
+func (r *taskAssigneeRepo) DeleteOne(_ context.Context, taskID uint, userID uint) error {
+	if err := r.db.
+		Where("task_id = ? AND user_id = ?", taskID, userID).
+		Delete(&entity.TaskAssignee{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindUserIDsByTaskIDs fetches ids of users assigned to list of tasks,
+// grouped by task id.
+func (r *taskAssigneeRepo) FindUserIDsByTaskIDs(_ context.Context, taskIDs []uint) (map[uint][]uint, error) {
+	result := make(map[uint][]uint)
+	if len(taskIDs) == 0 {
+		return result, nil
+	}
+
+	var assignees []entity.TaskAssignee
+
+	if err := r.db.
+		Table(entity.TaskAssignee{}.TableName()).
+		Where("task_id IN ?", taskIDs).
+		Order("created_at asc").
+		Find(&assignees).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, a := range assignees {
+		result[a.TaskID] = append(result[a.TaskID], a.UserID)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// taskDependencyRepo implements methods of task dependency's repository.
+type taskDependencyRepo struct {
+	db *gorm.DB
+}
+
+// NewTaskDependencyRepo creates and returns a new instance of
+// TaskDependencyRepo.
+func NewTaskDependencyRepo(db *gorm.DB) store.TaskDependencyRepo {
+	return &taskDependencyRepo{db: db}
+}
+
+// InsertOne inserts a dependency to database. Existing dependency is ignored.
+func (r *taskDependencyRepo) InsertOne(_ context.Context, dependency *entity.TaskDependency) error {
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(dependency).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a dependency from database.
+func (r *taskDependencyRepo) DeleteOne(_ context.Context, taskID uint, blockerID uint) error {
+	if err := r.db.
+		Where("task_id = ? AND blocker_id = ?", taskID, blockerID).
+		Delete(&entity.TaskDependency{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindBlockerIDsByTaskIDs fetches ids of tasks blocking list of tasks, grouped
+// by blocked task id.
+func (r *taskDependencyRepo) FindBlockerIDsByTaskIDs(_ context.Context, taskIDs []uint) (map[uint][]uint, error) {
+	result := make(map[uint][]uint)
+	if len(taskIDs) == 0 {
+		return result, nil
+	}
+
+	var dependencies []entity.TaskDependency
+
+	if err := r.db.
+		Table(entity.TaskDependency{}.TableName()).
+		Where("task_id IN ?", taskIDs).
+		Find(&dependencies).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, d := range dependencies {
+		result[d.TaskID] = append(result[d.TaskID], d.BlockerID)
+	}
+
+	return result, nil
+}
+
+// FindUnfinishedBlockerIDs fetches ids of tasks blocking a task which are not
+// closed yet.
+func (r *taskDependencyRepo) FindUnfinishedBlockerIDs(_ context.Context, taskID uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.
+		Table(entity.TaskDependency{}.TableName()+" AS d").
+		Joins("JOIN "+entity.Task{}.TableName()+" AS b ON b.id = d.blocker_id").
+		Where("d.task_id = ? AND b.status_category <> ?", taskID, entity.StatusCategoryClosed).
+		Pluck("d.blocker_id", &ids).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,79 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// taskShareRepo implements methods of task share's repository.
+type taskShareRepo struct {
+	db *gorm.DB
+}
+
+// NewTaskShareRepo creates and returns a new instance of TaskShareRepo.
+func NewTaskShareRepo(db *gorm.DB) store.TaskShareRepo {
+	return &taskShareRepo{db: db}
+}
+
+// UpsertOne shares a task with an user, replacing the permission granted
+// before if any.
+func (r *taskShareRepo) UpsertOne(_ context.Context, share *entity.TaskShare) error {
+	if err := r.db.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"permission", "updated_at"}),
+	}).Create(share).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne stops sharing a task with an user.
+func (r *taskShareRepo) DeleteOne(_ context.Context, taskID uint, userID uint) error {
+	if err := r.db.
+		Where("task_id = ? AND user_id = ?", taskID, userID).
+		Delete(&entity.TaskShare{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches share of a task with an user.
+func (r *taskShareRepo) FindOne(_ context.Context, taskID uint, userID uint) (*entity.TaskShare, error) {
+	var data entity.TaskShare
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where("task_id = ? AND user_id = ?", taskID, userID).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindByTaskID fetches all shares of a task.
+func (r *taskShareRepo) FindByTaskID(_ context.Context, taskID uint) ([]entity.TaskShare, error) {
+	var shares []entity.TaskShare
+
+	if err := r.db.
+		Table(entity.TaskShare{}.TableName()).
+		Where("task_id = ?", taskID).
+		Order("created_at asc").
+		Find(&shares).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,321 @@
+package storeimpl
+
+import (
+	"context"
+	"testing"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+// newTestDB opens a fresh in-memory database with tables of given models.
+func newTestDB(t *testing.T, models ...interface{}) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+
+	// In-memory database lives as long as its connection
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("get connection: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	return db
+}
+
+// tenantContext returns a context of a requester in tenant tid.
+func tenantContext(tid string) context.Context {
+	return kitcontext.WithUID(context.Background(), kitcontext.UID{Sub: "requester", Tid: tid})
+}
+
+// insertTenantTask inserts a task on behalf of tenant tid.
+func insertTenantTask(t *testing.T, repo *taskRepo, tid string, task *entity.Task) *entity.Task {
+	t.Helper()
+
+	if task.Status == "" {
+		task.Status = entity.StatusTodo
+	}
+	task.StatusCategory = task.Status.Category()
+
+	if err := repo.InsertOne(tenantContext(tid), task); err != nil {
+		t.Fatalf("insert task: %v", err)
+	}
+
+	return task
+}
+
+func TestTaskRepo_InsertOneStampsTenant(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	// Tenant given by caller is ignored
+	task := insertTenantTask(t, repo, "a", &entity.Task{Title: "task", TenantID: "b"})
+
+	var stored entity.Task
+	if err := db.First(&stored, task.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+
+	if stored.TenantID != "a" {
+		t.Errorf("tenant = %q, want %q", stored.TenantID, "a")
+	}
+}
+
+func TestTaskRepo_FindOneIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	task := insertTenantTask(t, repo, "b", &entity.Task{Title: "task of b"})
+
+	if _, err := repo.FindOne(tenantContext("a"), task.ID); err != kiterrors.ErrRepoEntityNotFound {
+		t.Errorf("find task of other tenant: err = %v, want %v", err, kiterrors.ErrRepoEntityNotFound)
+	}
+
+	found, err := repo.FindOne(tenantContext("b"), task.ID)
+	if err != nil {
+		t.Fatalf("find own task: %v", err)
+	}
+	if found.Title != task.Title {
+		t.Errorf("title = %q, want %q", found.Title, task.Title)
+	}
+}
+
+func TestTaskRepo_FindRangeByCriteriaIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	own := insertTenantTask(t, repo, "a", &entity.Task{Title: "task of a", UserID: 1})
+	insertTenantTask(t, repo, "b", &entity.Task{Title: "task of b", UserID: 1})
+
+	userID := uint(1)
+	paging := &core.Paging{Page: 1, Limit: 10}
+
+	tasks, err := repo.FindRangeByCriteria(tenantContext("a"), &entity.Filter{UserID: &userID}, nil, paging)
+	if err != nil {
+		t.Fatalf("find tasks: %v", err)
+	}
+
+	if len(tasks) != 1 || tasks[0].ID != own.ID {
+		t.Errorf("tasks = %+v, want only task %d", tasks, own.ID)
+	}
+	if paging.Total != 1 {
+		t.Errorf("total = %d, want 1", paging.Total)
+	}
+}
+
+func TestTaskRepo_UpdateOneIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	task := insertTenantTask(t, repo, "b", &entity.Task{Title: "task of b"})
+
+	update := *task
+	update.Title = "changed by a"
+	if err := repo.UpdateOne(tenantContext("a"), &update); err != nil {
+		t.Fatalf("update task: %v", err)
+	}
+
+	var stored entity.Task
+	if err := db.First(&stored, task.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+
+	if stored.Title != task.Title {
+		t.Errorf("title = %q, want %q", stored.Title, task.Title)
+	}
+	if stored.TenantID != "b" {
+		t.Errorf("tenant = %q, want %q", stored.TenantID, "b")
+	}
+}
+
+func TestTaskRepo_DeleteOneIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	task := insertTenantTask(t, repo, "b", &entity.Task{Title: "task of b"})
+
+	if err := repo.DeleteOne(tenantContext("a"), task.ID); err != nil {
+		t.Fatalf("delete task: %v", err)
+	}
+
+	var stored entity.Task
+	if err := db.First(&stored, task.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+
+	if stored.Status != entity.StatusTodo || stored.DeletedAt != nil {
+		t.Errorf("status = %q, deleted at = %v, want task untouched", stored.Status, stored.DeletedAt)
+	}
+}
+
+func TestTaskRepo_ArchiveOneIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	task := insertTenantTask(t, repo, "b", &entity.Task{Title: "task of b"})
+
+	if err := repo.ArchiveOne(tenantContext("a"), task.ID); err != nil {
+		t.Fatalf("archive task: %v", err)
+	}
+
+	var stored entity.Task
+	if err := db.First(&stored, task.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+
+	if stored.Status != entity.StatusTodo || stored.PreviousStatus != nil {
+		t.Errorf("status = %q, previous status = %v, want task untouched", stored.Status, stored.PreviousStatus)
+	}
+}
+
+func TestTaskRepo_CountSubtasksIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Task{})
+	repo := &taskRepo{db: db}
+
+	parent := insertTenantTask(t, repo, "a", &entity.Task{Title: "parent"})
+	insertTenantTask(t, repo, "a", &entity.Task{Title: "subtask of a", ParentID: &parent.ID, Status: entity.StatusDone})
+	// Foreign subtask pointing to the same parent must not be counted
+	insertTenantTask(t, repo, "b", &entity.Task{Title: "subtask of b", ParentID: &parent.ID})
+
+	stats, err := repo.CountSubtasks(tenantContext("a"), []uint{parent.ID})
+	if err != nil {
+		t.Fatalf("count subtasks: %v", err)
+	}
+
+	if got := stats[parent.ID]; got.Total != 1 || got.Done != 1 {
+		t.Errorf("stats = %+v, want total 1, done 1", got)
+	}
+
+	stats, err = repo.CountSubtasks(tenantContext("b"), []uint{parent.ID})
+	if err != nil {
+		t.Fatalf("count subtasks: %v", err)
+	}
+
+	if got := stats[parent.ID]; got.Total != 1 || got.Done != 0 {
+		t.Errorf("stats = %+v, want total 1, done 0", got)
+	}
+}
+
+// insertTenantProject inserts an active project on behalf of tenant tid.
+func insertTenantProject(t *testing.T, repo *projectRepo, tid string, project *entity.Project) *entity.Project {
+	t.Helper()
+
+	project.Status = entity.ProjectStatusActive
+
+	if err := repo.InsertOne(tenantContext(tid), project); err != nil {
+		t.Fatalf("insert project: %v", err)
+	}
+
+	return project
+}
+
+func TestProjectRepo_InsertOneStampsTenant(t *testing.T) {
+	db := newTestDB(t, &entity.Project{})
+	repo := &projectRepo{db: db}
+
+	project := insertTenantProject(t, repo, "a", &entity.Project{Name: "project", TenantID: "b"})
+
+	var stored entity.Project
+	if err := db.First(&stored, project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+
+	if stored.TenantID != "a" {
+		t.Errorf("tenant = %q, want %q", stored.TenantID, "a")
+	}
+}
+
+func TestProjectRepo_FindIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Project{}, &entity.RoleBinding{})
+	repo := &projectRepo{db: db}
+
+	own := insertTenantProject(t, repo, "a", &entity.Project{Name: "project of a", UserID: 1})
+	foreign := insertTenantProject(t, repo, "b", &entity.Project{Name: "project of b", UserID: 1})
+
+	if _, err := repo.FindOne(tenantContext("a"), foreign.ID); err != kiterrors.ErrRepoEntityNotFound {
+		t.Errorf("find project of other tenant: err = %v, want %v", err, kiterrors.ErrRepoEntityNotFound)
+	}
+
+	userID := uint(1)
+	paging := &core.Paging{Page: 1, Limit: 10}
+
+	projects, err := repo.FindRangeByCriteria(tenantContext("a"), &entity.ProjectFilter{MemberID: &userID}, paging)
+	if err != nil {
+		t.Fatalf("find projects: %v", err)
+	}
+
+	if len(projects) != 1 || projects[0].ID != own.ID || paging.Total != 1 {
+		t.Errorf("projects = %+v, total = %d, want only project %d", projects, paging.Total, own.ID)
+	}
+}
+
+func TestProjectRepo_UpdateOneIsolatesTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Project{})
+	repo := &projectRepo{db: db}
+
+	project := insertTenantProject(t, repo, "b", &entity.Project{Name: "project of b"})
+
+	update := *project
+	update.Name = "changed by a"
+	if err := repo.UpdateOne(tenantContext("a"), &update); err != nil {
+		t.Fatalf("update project: %v", err)
+	}
+
+	var stored entity.Project
+	if err := db.First(&stored, project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+
+	if stored.Name != project.Name || stored.TenantID != "b" {
+		t.Errorf("name = %q, tenant = %q, want project untouched", stored.Name, stored.TenantID)
+	}
+}
+
+func TestProjectRepo_DeleteAndArchiveIsolateTenants(t *testing.T) {
+	db := newTestDB(t, &entity.Project{}, &entity.Task{})
+	repo := &projectRepo{db: db}
+	tasks := &taskRepo{db: db}
+
+	project := insertTenantProject(t, repo, "b", &entity.Project{Name: "project of b"})
+	task := insertTenantTask(t, tasks, "b", &entity.Task{Title: "task of b", ProjectID: &project.ID})
+
+	if err := repo.ArchiveOne(tenantContext("a"), project.ID); err != nil {
+		t.Fatalf("archive project: %v", err)
+	}
+	if err := repo.DeleteOne(tenantContext("a"), project.ID); err != nil {
+		t.Fatalf("delete project: %v", err)
+	}
+
+	var stored entity.Project
+	if err := db.First(&stored, project.ID).Error; err != nil {
+		t.Fatalf("load project: %v", err)
+	}
+	if stored.Status != entity.ProjectStatusActive {
+		t.Errorf("project status = %q, want %q", stored.Status, entity.ProjectStatusActive)
+	}
+
+	var storedTask entity.Task
+	if err := db.First(&storedTask, task.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+	if storedTask.Status != entity.StatusTodo {
+		t.Errorf("task status = %q, want %q", storedTask.Status, entity.StatusTodo)
+	}
+}
@@ -0,0 +1,60 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// taskWatcherRepo implements methods of task watcher's repository.
+type taskWatcherRepo struct {
+	db *gorm.DB
+}
+
+// NewTaskWatcherRepo creates and returns a new instance of TaskWatcherRepo.
+func NewTaskWatcherRepo(db *gorm.DB) store.TaskWatcherRepo {
+	return &taskWatcherRepo{db: db}
+}
+
+// InsertOne makes an user watch a task. User already watching is ignored.
+func (r *taskWatcherRepo) InsertOne(_ context.Context, taskID uint, userID uint) error {
+	watcher := &entity.TaskWatcher{TaskID: taskID, UserID: userID}
+
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(watcher).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne makes an user stop watching a task.
+func (r *taskWatcherRepo) DeleteOne(_ context.Context, taskID uint, userID uint) error {
+	if err := r.db.
+		Where("task_id = ? AND user_id = ?", taskID, userID).
+		Delete(&entity.TaskWatcher{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindUserIDsByTaskID fetches ids of users watching a task, in order they
+// started watching.
+func (r *taskWatcherRepo) FindUserIDsByTaskID(_ context.Context, taskID uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.
+		Table(entity.TaskWatcher{}.TableName()).
+		Where("task_id = ?", taskID).
+		Order("created_at asc").
+		Pluck("user_id", &ids).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return ids, nil
+}
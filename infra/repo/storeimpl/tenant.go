@@ -0,0 +1,24 @@
+package storeimpl
+
+import (
+	"context"
+
+	kitcontext "github.com/quocdaitrn/golang-kit/context"
+	"gorm.io/gorm"
+)
+
+// tenantFromContext returns id of the tenant of requester, as returned by
+// token introspection.
+func tenantFromContext(ctx context.Context) string {
+	return kitcontext.UIDFromContext(ctx).Tid
+}
+
+// tenantScope restricts a query to rows of the tenant of requester. column is
+// the tenant column, qualified by table alias if needed.
+func tenantScope(ctx context.Context, column string) func(db *gorm.DB) *gorm.DB {
+	tid := tenantFromContext(ctx)
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(column+" = ?", tid)
+	}
+}
@@ -0,0 +1,350 @@
+package storeimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// webhookRepo implements methods of webhook's repository.
+type webhookRepo struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepo creates and returns a new instance of WebhookRepo.
+func NewWebhookRepo(db *gorm.DB) store.WebhookRepo {
+	return &webhookRepo{db: db}
+}
+
+// InsertOne inserts a webhook to database.
+func (r *webhookRepo) InsertOne(ctx context.Context, webhook *entity.Webhook) error {
+	// Webhook receives events of tenant of its creator only
+	webhook.TenantID = tenantFromContext(ctx)
+
+	if err := r.db.Create(webhook).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// UpdateOne updates a webhook in database.
+func (r *webhookRepo) UpdateOne(ctx context.Context, webhook *entity.Webhook) error {
+	// Select all columns so disabled time can be cleared too
+	if err := r.db.Table(webhook.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ?", webhook.ID).
+		Select("*").
+		Omit("id", "tenant_id", "created_at").
+		Updates(webhook).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a webhook from database, along with its deliveries.
+func (r *webhookRepo) DeleteOne(ctx context.Context, id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Scopes(tenantScope(ctx, "tenant_id")).
+			Where("id = ?", id).
+			Delete(&entity.Webhook{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		deliveries := tx.Table(entity.WebhookDelivery{}.TableName()).
+			Select("id").
+			Where("webhook_id = ?", id)
+
+		if err := tx.Where("delivery_id IN (?)", deliveries).Delete(&entity.WebhookAttempt{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("webhook_id = ?", id).Delete(&entity.WebhookDelivery{}).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches a webhook from database by id.
+func (r *webhookRepo) FindOne(ctx context.Context, id uint) (*entity.Webhook, error) {
+	var data entity.Webhook
+
+	if err := r.db.
+		Table(data.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindRange fetches list of webhooks, newest first.
+func (r *webhookRepo) FindRange(ctx context.Context, paging *core.Paging) ([]entity.Webhook, error) {
+	var webhooks []entity.Webhook
+
+	db := r.db.
+		Table(entity.Webhook{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id"))
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id desc").
+		Find(&webhooks).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return webhooks, nil
+}
+
+// FindActive fetches all active webhooks.
+func (r *webhookRepo) FindActive(ctx context.Context) ([]entity.Webhook, error) {
+	var webhooks []entity.Webhook
+
+	if err := r.db.
+		Table(entity.Webhook{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("status = ?", entity.WebhookStatusActive).
+		Order("id asc").
+		Find(&webhooks).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return webhooks, nil
+}
+
+// RecordSuccess resets count of failed attempts of a webhook in a row.
+func (r *webhookRepo) RecordSuccess(_ context.Context, id uint) error {
+	if err := r.db.Table(entity.Webhook{}.TableName()).
+		Where("id = ? AND failure_count > 0", id).
+		UpdateColumn("failure_count", 0).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// RecordFailure counts a failed attempt of a webhook, disabling it once
+// failures in a row reach disableAfter.
+func (r *webhookRepo) RecordFailure(_ context.Context, id uint, disableAfter int, now time.Time) (bool, error) {
+	disabled := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(entity.Webhook{}.TableName()).
+			Where("id = ?", id).
+			UpdateColumn("failure_count", gorm.Expr("failure_count + 1")).Error; err != nil {
+			return err
+		}
+
+		// Condition on status makes only one of concurrent failures disable
+		// the webhook
+		res := tx.Table(entity.Webhook{}.TableName()).
+			Where("id = ? AND status = ? AND failure_count >= ?", id, entity.WebhookStatusActive, disableAfter).
+			UpdateColumns(map[string]interface{}{
+				"status":      entity.WebhookStatusDisabled,
+				"disabled_at": now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		disabled = res.RowsAffected > 0
+
+		return nil
+	})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	return disabled, nil
+}
+
+// InsertDeliveries inserts list of deliveries to database.
+func (r *webhookRepo) InsertDeliveries(_ context.Context, deliveries []entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := r.db.Create(&deliveries).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindDelivery fetches a delivery from database by id.
+func (r *webhookRepo) FindDelivery(_ context.Context, id uint) (*entity.WebhookDelivery, error) {
+	var data entity.WebhookDelivery
+
+	if err := r.db.
+		Table(data.TableName()).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	return &data, nil
+}
+
+// FindDeliveries fetches list of deliveries of a webhook, newest first.
+func (r *webhookRepo) FindDeliveries(_ context.Context, webhookID uint, paging *core.Paging) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+
+	db := r.db.
+		Table(entity.WebhookDelivery{}.TableName()).
+		Where("webhook_id = ?", webhookID)
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id desc").
+		Find(&deliveries).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return deliveries, nil
+}
+
+// FindAttempts fetches all attempts of a delivery, in order.
+func (r *webhookRepo) FindAttempts(_ context.Context, deliveryID uint) ([]entity.WebhookAttempt, error) {
+	var attempts []entity.WebhookAttempt
+
+	if err := r.db.
+		Where("delivery_id = ?", deliveryID).
+		Order("attempt asc").
+		Find(&attempts).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return attempts, nil
+}
+
+// ClaimDueDeliveries fetches pending deliveries of all tenants whose next
+// attempt is due at the given time, locking them for lease.
+func (r *webhookRepo) ClaimDueDeliveries(_ context.Context, now time.Time, lease time.Duration, limit int) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Rows being claimed by another instance are skipped rather than
+		// waited for, so each attempt is made by one instance only
+		if err := tx.Table("webhook_deliveries d").
+			Select("d.*").
+			Joins("JOIN webhooks w ON w.id = d.webhook_id").
+			Where("d.status = ? AND d.next_attempt_at <= ?", entity.WebhookDeliveryStatusPending, now).
+			Where("d.locked_until IS NULL OR d.locked_until < ?", now).
+			Where("w.status = ?", entity.WebhookStatusActive).
+			Order("d.next_attempt_at asc").
+			Limit(limit).
+			Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "d"}, Options: "SKIP LOCKED"}).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(deliveries))
+		webhookIDs := make([]uint, len(deliveries))
+
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+			webhookIDs[i] = deliveries[i].WebhookID
+		}
+
+		if err := tx.Model(&entity.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			UpdateColumn("locked_until", now.Add(lease)).Error; err != nil {
+			return err
+		}
+
+		var webhooks []entity.Webhook
+		if err := tx.Where("id IN ?", webhookIDs).Find(&webhooks).Error; err != nil {
+			return err
+		}
+
+		// For speed up mapping data
+		webhookMap := make(map[uint]*entity.Webhook, len(webhooks))
+
+		for i := range webhooks {
+			webhookMap[webhooks[i].ID] = &webhooks[i]
+		}
+
+		for i := range deliveries {
+			deliveries[i].Webhook = webhookMap[deliveries[i].WebhookID]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return deliveries, nil
+}
+
+// RecordAttempt inserts an attempt of a claimed delivery, and saves the
+// outcome of the attempt on the delivery, releasing it.
+func (r *webhookRepo) RecordAttempt(_ context.Context, delivery *entity.WebhookDelivery, attempt *entity.WebhookAttempt) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(attempt).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.WebhookDelivery{}).
+			Where("id = ?", delivery.ID).
+			Updates(map[string]interface{}{
+				"status":          delivery.Status,
+				"attempts":        delivery.Attempts,
+				"response_status": delivery.ResponseStatus,
+				"last_error":      delivery.LastError,
+				"next_attempt_at": delivery.NextAttemptAt,
+				"delivered_at":    delivery.DeliveredAt,
+				"locked_until":    nil,
+			}).Error
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package storeimpl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kiterrors "github.com/quocdaitrn/golang-kit/errors"
+	"github.com/viettranx/service-context/core"
+	"gorm.io/gorm"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+	"github.com/quocdaitrn/cp-task/domain/repo/store"
+)
+
+// workflowRepo implements methods of workflow's repository.
+type workflowRepo struct {
+	db *gorm.DB
+}
+
+// NewWorkflowRepo creates and returns a new instance of WorkflowRepo.
+func NewWorkflowRepo(db *gorm.DB) store.WorkflowRepo {
+	return &workflowRepo{db: db}
+}
+
+// InsertOne inserts a workflow with its statuses and transitions to database.
+func (r *workflowRepo) InsertOne(ctx context.Context, workflow *entity.Workflow) error {
+	// Workflow can only be used by tasks of tenant of its creator
+	workflow.TenantID = tenantFromContext(ctx)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(workflow).Error; err != nil {
+			return err
+		}
+
+		return insertWorkflowDetails(tx, workflow)
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// UpdateOne updates a workflow to database, replacing its statuses and
+// transitions. Tasks in a kept status whose category changed follow the new
+// category.
+func (r *workflowRepo) UpdateOne(ctx context.Context, workflow *entity.Workflow) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Table(workflow.TableName()).
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("id = ?", workflow.ID).
+			Omit("tenant_id").
+			Updates(workflow)
+		if res.Error != nil {
+			return res.Error
+		}
+
+		// Details of workflows of other tenants are left untouched
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		var previous []entity.WorkflowStatus
+		if err := tx.Where("workflow_id = ?", workflow.ID).Find(&previous).Error; err != nil {
+			return err
+		}
+
+		if err := deleteWorkflowDetails(tx, workflow.ID); err != nil {
+			return err
+		}
+
+		if err := insertWorkflowDetails(tx, workflow); err != nil {
+			return err
+		}
+
+		return updateTaskCategories(ctx, tx, workflow, previous)
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a workflow with its statuses and transitions from
+// database.
+func (r *workflowRepo) DeleteOne(ctx context.Context, id uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Scopes(tenantScope(ctx, "tenant_id")).
+			Where("id = ?", id).
+			Delete(&entity.Workflow{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return nil
+		}
+
+		return deleteWorkflowDetails(tx, id)
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// FindOne fetches a workflow with its statuses and transitions from database
+// by id.
+func (r *workflowRepo) FindOne(ctx context.Context, id uint) (*entity.Workflow, error) {
+	var data entity.Workflow
+
+	if err := r.db.
+		Table(data.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id")).
+		Where("id = ?", id).
+		First(&data).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, kiterrors.ErrRepoEntityNotFound
+		}
+
+		return nil, errors.WithStack(err)
+	}
+
+	workflows := []entity.Workflow{data}
+	if err := r.loadDetails(workflows); err != nil {
+		return nil, err
+	}
+
+	return &workflows[0], nil
+}
+
+// FindRangeByCriteria fetches list of workflows with their statuses and
+// transitions by criteria.
+func (r *workflowRepo) FindRangeByCriteria(ctx context.Context, filter *entity.WorkflowFilter, paging *core.Paging) ([]entity.Workflow, error) {
+	var workflows []entity.Workflow
+
+	db := r.db.
+		Table(entity.Workflow{}.TableName()).
+		Scopes(tenantScope(ctx, "tenant_id"))
+
+	if filter.ProjectID != nil {
+		db = db.Where("project_id = ?", *filter.ProjectID)
+	} else {
+		db = db.Where("project_id IS NULL")
+	}
+
+	// Count total records match conditions
+	if err := db.Select("id").Count(&paging.Total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// Query data with paging
+	if err := db.Select("*").
+		Offset((paging.Page - 1) * paging.Limit).
+		Limit(paging.Limit).
+		Order("id desc").
+		Find(&workflows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := r.loadDetails(workflows); err != nil {
+		return nil, err
+	}
+
+	return workflows, nil
+}
+
+// loadDetails fills statuses and transitions of list of workflows, in one
+// query for each kind.
+func (r *workflowRepo) loadDetails(workflows []entity.Workflow) error {
+	if len(workflows) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(workflows))
+	index := make(map[uint]int, len(workflows))
+
+	for i := range workflows {
+		ids[i] = workflows[i].ID
+		index[workflows[i].ID] = i
+	}
+
+	var statuses []entity.WorkflowStatus
+	if err := r.db.
+		Table(entity.WorkflowStatus{}.TableName()).
+		Where("workflow_id IN ?", ids).
+		Order("position asc").
+		Find(&statuses).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	var transitions []entity.WorkflowTransition
+	if err := r.db.
+		Table(entity.WorkflowTransition{}.TableName()).
+		Where("workflow_id IN ?", ids).
+		Find(&transitions).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	for _, s := range statuses {
+		w := &workflows[index[s.WorkflowID]]
+		w.Statuses = append(w.Statuses, s)
+	}
+
+	for _, t := range transitions {
+		w := &workflows[index[t.WorkflowID]]
+		w.Transitions = append(w.Transitions, t)
+	}
+
+	return nil
+}
+
+// insertWorkflowDetails inserts statuses and transitions of workflow, keeping
+// order of statuses as their positions.
+func insertWorkflowDetails(tx *gorm.DB, workflow *entity.Workflow) error {
+	for i := range workflow.Statuses {
+		workflow.Statuses[i].ID = 0
+		workflow.Statuses[i].WorkflowID = workflow.ID
+		workflow.Statuses[i].Position = i
+	}
+
+	for i := range workflow.Transitions {
+		workflow.Transitions[i].WorkflowID = workflow.ID
+	}
+
+	if len(workflow.Statuses) > 0 {
+		if err := tx.Create(&workflow.Statuses).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(workflow.Transitions) > 0 {
+		if err := tx.Create(&workflow.Transitions).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateTaskCategories sets the new category on tasks of workflow in each
+// status which is kept from previous statuses but changed its category, so
+// checks relying on categories of tasks stay right.
+func updateTaskCategories(ctx context.Context, tx *gorm.DB, workflow *entity.Workflow, previous []entity.WorkflowStatus) error {
+	categories := make(map[entity.Status]entity.StatusCategory, len(previous))
+	for _, st := range previous {
+		categories[st.Key] = st.Category
+	}
+
+	for _, st := range workflow.Statuses {
+		category, kept := categories[st.Key]
+		if !kept || category == st.Category {
+			continue
+		}
+
+		if err := tx.Table(entity.Task{}.TableName()).
+			Scopes(tenantScope(ctx, "tenant_id")).
+			Where("workflow_id = ? AND status = ?", workflow.ID, st.Key).
+			UpdateColumn("status_category", st.Category).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteWorkflowDetails deletes statuses and transitions of workflow.
+func deleteWorkflowDetails(tx *gorm.DB, workflowID uint) error {
+	if err := tx.Where("workflow_id = ?", workflowID).Delete(&entity.WorkflowTransition{}).Error; err != nil {
+		return err
+	}
+
+	return tx.Where("workflow_id = ?", workflowID).Delete(&entity.WorkflowStatus{}).Error
+}
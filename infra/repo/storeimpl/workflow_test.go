@@ -0,0 +1,61 @@
+package storeimpl
+
+import (
+	"testing"
+
+	"github.com/quocdaitrn/cp-task/domain/entity"
+)
+
+func TestWorkflowRepo_UpdateOneMovesTasksToNewCategory(t *testing.T) {
+	db := newTestDB(t, &entity.Task{}, &entity.Workflow{}, &entity.WorkflowStatus{}, &entity.WorkflowTransition{})
+	repo := &workflowRepo{db: db}
+	tasks := &taskRepo{db: db}
+
+	ctx := tenantContext("a")
+
+	workflow := &entity.Workflow{
+		Name: "review",
+		Statuses: []entity.WorkflowStatus{
+			{Key: "todo", Name: "To do", Category: entity.StatusCategoryOpen},
+			{Key: "review", Name: "Review", Category: entity.StatusCategoryInProgress},
+		},
+	}
+	if err := repo.InsertOne(ctx, workflow); err != nil {
+		t.Fatalf("insert workflow: %v", err)
+	}
+
+	inReview := &entity.Task{Title: "in review", WorkflowID: &workflow.ID, Status: "review", StatusCategory: entity.StatusCategoryInProgress}
+	if err := tasks.InsertOne(ctx, inReview); err != nil {
+		t.Fatalf("insert task: %v", err)
+	}
+
+	// Same status key in another tenant must be left alone
+	foreign := &entity.Task{Title: "foreign", WorkflowID: &workflow.ID, Status: "review", StatusCategory: entity.StatusCategoryInProgress}
+	if err := tasks.InsertOne(tenantContext("b"), foreign); err != nil {
+		t.Fatalf("insert task: %v", err)
+	}
+
+	workflow.Statuses = []entity.WorkflowStatus{
+		{Key: "todo", Name: "To do", Category: entity.StatusCategoryOpen},
+		{Key: "review", Name: "Reviewed", Category: entity.StatusCategoryClosed},
+	}
+	if err := repo.UpdateOne(ctx, workflow); err != nil {
+		t.Fatalf("update workflow: %v", err)
+	}
+
+	var stored entity.Task
+	if err := db.First(&stored, inReview.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+	if stored.StatusCategory != entity.StatusCategoryClosed {
+		t.Errorf("category = %q, want %q", stored.StatusCategory, entity.StatusCategoryClosed)
+	}
+
+	var storedForeign entity.Task
+	if err := db.First(&storedForeign, foreign.ID).Error; err != nil {
+		t.Fatalf("load task: %v", err)
+	}
+	if storedForeign.StatusCategory != entity.StatusCategoryInProgress {
+		t.Errorf("category of other tenant = %q, want %q", storedForeign.StatusCategory, entity.StatusCategoryInProgress)
+	}
+}
@@ -0,0 +1,104 @@
+package webhookimpl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/webhook"
+)
+
+// maxResponseBody is number of bytes of response body kept for a delivery.
+const maxResponseBody = 1 << 10
+
+// httpSender implements sender by posting payloads over HTTP.
+type httpSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender creates and returns a new instance of Sender posting payloads
+// with given timeout, so a slow endpoint can not hold deliveries. Endpoints
+// are user provided, so private, link-local and, unless allowLoopback is set,
+// loopback addresses are refused and redirects are not followed, keeping
+// internal services out of reach.
+func NewHTTPSender(timeout time.Duration, allowLoopback bool) webhook.Sender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Checked on the resolved address, so a public host name pointing to
+		// an internal address is refused too
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkAddress(address, allowLoopback)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &httpSender{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send posts a request to its endpoint.
+func (s *httpSender) Send(ctx context.Context, req *webhook.Request) (*webhook.Response, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for name, values := range req.Headers {
+		httpReq.Header[name] = values
+	}
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+
+	// Drain the rest, so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return &webhook.Response{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}, nil
+}
+
+// checkAddress fails if address, as host:port, is not a public address a
+// webhook can be delivered to.
+func checkAddress(address string, allowLoopback bool) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("webhook address %s is not an ip", host)
+	}
+
+	if ip.IsLoopback() && allowLoopback {
+		return nil
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return errors.Errorf("webhook address %s is not public", ip)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package webhookimpl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/quocdaitrn/cp-task/domain/repo/webhook"
+)
+
+func TestHTTPSender_RefusesLoopbackUnlessAllowed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	req := &webhook.Request{URL: server.URL, Body: []byte("{}")}
+
+	if _, err := NewHTTPSender(time.Second, false).Send(context.Background(), req); err == nil {
+		t.Error("send to loopback address: err = nil, want refused")
+	}
+
+	resp, err := NewHTTPSender(time.Second, true).Send(context.Background(), req)
+	if err != nil {
+		t.Fatalf("send to allowed loopback address: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestHTTPSender_DoesNotFollowRedirects(t *testing.T) {
+	var redirected bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/internal", http.StatusFound)
+	})
+	mux.HandleFunc("/internal", func(w http.ResponseWriter, _ *http.Request) {
+		redirected = true
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resp, err := NewHTTPSender(time.Second, true).Send(context.Background(), &webhook.Request{URL: server.URL + "/hook"})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusFound)
+	}
+	if redirected {
+		t.Error("redirect was followed")
+	}
+}
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		address       string
+		allowLoopback bool
+		wantErr       bool
+	}{
+		{address: "93.184.216.34:443"},
+		{address: "[2606:2800:220:1::]:443"},
+		{address: "127.0.0.1:80", wantErr: true},
+		{address: "127.0.0.1:80", allowLoopback: true},
+		{address: "[::1]:80", wantErr: true},
+		{address: "10.0.0.1:80", wantErr: true},
+		{address: "172.16.0.1:80", wantErr: true},
+		{address: "192.168.1.1:80", wantErr: true},
+		{address: "169.254.169.254:80", wantErr: true},
+		{address: "[fe80::1]:80", wantErr: true},
+		{address: "[fd00::1]:80", wantErr: true},
+		{address: "0.0.0.0:80", wantErr: true},
+		{address: "10.0.0.1:80", allowLoopback: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := checkAddress(tt.address, tt.allowLoopback)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("checkAddress(%q, %v) = %v, want error %v", tt.address, tt.allowLoopback, err, tt.wantErr)
+		}
+	}
+}